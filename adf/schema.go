@@ -0,0 +1,219 @@
+package adf
+
+// nodeSpec describes the structural rules for a single ADF node type.
+type nodeSpec struct {
+	// content lists the node types allowed as children. A nil slice means
+	// the node must not have content.
+	content []string
+
+	// first, when set, restricts the type of the first child.
+	first []string
+
+	// minContent is the minimum number of children required.
+	minContent int
+
+	// required lists attrs that must be present and non-empty.
+	required []string
+
+	// requiredOneOf lists attrs of which at least one must be present.
+	requiredOneOf []string
+
+	// enums restricts string attrs to a fixed set of values.
+	enums map[string][]string
+
+	// marks lists the marks allowed on the node itself (block marks) or,
+	// for text nodes, the inline marks allowed.
+	marks []string
+
+	// textMarks overrides the marks allowed on text children (e.g., none in codeBlock).
+	// A nil slice means text children follow the text node rules.
+	textMarks []string
+}
+
+// Content groups from the published ADF schema.
+//
+//nolint:gochecknoglobals // Immutable schema tables
+var (
+	inlineNodes = []string{
+		"text", "hardBreak", "mention", "emoji", "inlineCard", "status",
+		"date", "placeholder", "inlineExtension", "mediaInline",
+	}
+
+	topLevelBlocks = []string{
+		"paragraph", "heading", "bulletList", "orderedList", "codeBlock",
+		"blockquote", "rule", "panel", "table", "mediaSingle", "mediaGroup",
+		"expand", "taskList", "decisionList", "blockCard", "embedCard",
+		"layoutSection", "extension", "bodiedExtension",
+	}
+
+	listItemContent = []string{
+		"paragraph", "bulletList", "orderedList", "codeBlock", "mediaSingle",
+		"taskList", "extension",
+	}
+
+	blockquoteContent = []string{
+		"paragraph", "bulletList", "orderedList", "codeBlock", "mediaGroup",
+		"mediaSingle", "extension",
+	}
+
+	panelContent = []string{
+		"paragraph", "heading", "bulletList", "orderedList", "blockCard",
+		"mediaGroup", "mediaSingle", "codeBlock", "taskList", "rule",
+		"decisionList", "extension",
+	}
+
+	tableCellContent = []string{
+		"paragraph", "panel", "blockquote", "orderedList", "bulletList",
+		"rule", "heading", "codeBlock", "mediaGroup", "mediaSingle",
+		"decisionList", "taskList", "blockCard", "embedCard", "extension",
+		"nestedExpand",
+	}
+
+	expandContent = []string{
+		"paragraph", "panel", "blockquote", "orderedList", "bulletList",
+		"rule", "heading", "codeBlock", "mediaGroup", "mediaSingle",
+		"decisionList", "taskList", "table", "blockCard", "embedCard",
+		"extension", "nestedExpand",
+	}
+
+	textMarks = []string{
+		"code", "em", "link", "strike", "strong", "subsup", "textColor",
+		"underline", "backgroundColor",
+	}
+)
+
+// schema maps each known node type to its rules.
+//
+//nolint:gochecknoglobals // Immutable schema table
+var schema = map[string]nodeSpec{
+	// Root
+	"doc": {content: topLevelBlocks},
+
+	// Block nodes
+	"paragraph": {content: inlineNodes, marks: []string{"alignment", "indentation"}},
+	"heading": {
+		content:  inlineNodes,
+		required: []string{"level"},
+		marks:    []string{"alignment", "indentation"},
+	},
+	"bulletList":  {content: []string{"listItem"}, minContent: 1},
+	"orderedList": {content: []string{"listItem"}, minContent: 1},
+	"listItem": {
+		content:    listItemContent,
+		first:      []string{"paragraph", "codeBlock", "mediaSingle"},
+		minContent: 1,
+	},
+	"codeBlock":  {content: []string{"text"}, textMarks: []string{}, marks: []string{"breakout"}},
+	"blockquote": {content: blockquoteContent, minContent: 1},
+	"rule":       {},
+	"panel": {
+		content:    panelContent,
+		minContent: 1,
+		required:   []string{"panelType"},
+		enums: map[string][]string{
+			"panelType": {"info", "note", "tip", "warning", "error", "success", "custom"},
+		},
+	},
+	"table":       {content: []string{"tableRow"}, minContent: 1},
+	"tableRow":    {content: []string{"tableHeader", "tableCell"}, minContent: 1},
+	"tableHeader": {content: tableCellContent, minContent: 1},
+	"tableCell":   {content: tableCellContent, minContent: 1},
+	"mediaSingle": {
+		content:    []string{"media", "caption"},
+		first:      []string{"media"},
+		minContent: 1,
+		marks:      []string{"link"},
+	},
+	"caption":      {content: inlineNodes},
+	"mediaGroup":   {content: []string{"media"}, minContent: 1},
+	"expand":       {content: expandContent, minContent: 1, marks: []string{"breakout"}},
+	"nestedExpand": {content: expandContent, minContent: 1},
+	"taskList": {
+		content:    []string{"taskItem", "taskList"},
+		first:      []string{"taskItem"},
+		minContent: 1,
+		required:   []string{"localId"},
+	},
+	"taskItem": {
+		content:  inlineNodes,
+		required: []string{"localId", "state"},
+		enums:    map[string][]string{"state": {"TODO", "DONE"}},
+	},
+	"decisionList": {content: []string{"decisionItem"}, minContent: 1, required: []string{"localId"}},
+	"decisionItem": {
+		content:  inlineNodes,
+		required: []string{"localId", "state"},
+		enums:    map[string][]string{"state": {"DECIDED"}},
+	},
+	"blockCard":       {requiredOneOf: []string{"url", "data"}},
+	"embedCard":       {required: []string{"url", "layout"}},
+	"layoutSection":   {content: []string{"layoutColumn"}, minContent: 1, marks: []string{"breakout"}},
+	"layoutColumn":    {content: topLevelBlocks, minContent: 1, required: []string{"width"}},
+	"extension":       {required: []string{"extensionKey", "extensionType"}},
+	"bodiedExtension": {content: topLevelBlocks, minContent: 1, required: []string{"extensionKey", "extensionType"}},
+	"media": {
+		required: []string{"type"},
+		enums:    map[string][]string{"type": {"file", "link", "external"}},
+		marks:    []string{"link", "annotation", "border"},
+	},
+
+	// Inline nodes
+	"text":       {marks: textMarks},
+	"hardBreak":  {},
+	"mention":    {required: []string{"id"}},
+	"emoji":      {required: []string{"shortName"}},
+	"inlineCard": {requiredOneOf: []string{"url", "data"}},
+	"status": {
+		required: []string{"text", "color"},
+		enums: map[string][]string{
+			"color": {"neutral", "purple", "blue", "red", "yellow", "green"},
+		},
+	},
+	"date":            {required: []string{"timestamp"}},
+	"placeholder":     {required: []string{"text"}},
+	"inlineExtension": {required: []string{"extensionKey", "extensionType"}},
+	"mediaInline":     {required: []string{"id"}},
+}
+
+// markSpec describes the rules for a single mark type.
+type markSpec struct {
+	// required lists attrs that must be present and non-empty.
+	required []string
+
+	// enums restricts string attrs to a fixed set of values.
+	enums map[string][]string
+
+	// excludes lists mark types that cannot be combined with this mark.
+	excludes []string
+}
+
+// marks maps each known mark type to its rules.
+//
+//nolint:gochecknoglobals // Immutable schema table
+var marks = map[string]markSpec{
+	"code": {excludes: []string{
+		"em", "strike", "strong", "subsup", "textColor", "underline", "backgroundColor",
+	}},
+	"em":     {},
+	"link":   {required: []string{"href"}},
+	"strike": {},
+	"strong": {},
+	"subsup": {
+		required: []string{"type"},
+		enums:    map[string][]string{"type": {"sub", "sup"}},
+	},
+	"textColor":       {required: []string{"color"}},
+	"backgroundColor": {required: []string{"color"}},
+	"underline":       {},
+	"alignment": {
+		required: []string{"align"},
+		enums:    map[string][]string{"align": {"center", "end"}},
+	},
+	"indentation": {required: []string{"level"}},
+	"breakout": {
+		required: []string{"mode"},
+		enums:    map[string][]string{"mode": {"wide", "full-width"}},
+	},
+	"annotation": {required: []string{"id", "annotationType"}},
+	"border":     {required: []string{"size", "color"}},
+}
@@ -0,0 +1,139 @@
+package adf
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Append returns a document with the top-level content of add after the
+// content of doc. A nil doc is treated as empty. Neither input is modified.
+func Append(doc, add jira4claude.ADF) jira4claude.ADF {
+	return withContent(doc, slices.Concat(contentOf(doc), contentOf(add)))
+}
+
+// Prepend returns a document with the top-level content of add before the
+// content of doc. A nil doc is treated as empty. Neither input is modified.
+func Prepend(doc, add jira4claude.ADF) jira4claude.ADF {
+	return withContent(doc, slices.Concat(contentOf(add), contentOf(doc)))
+}
+
+// ReplaceSection returns a document with the section under the top-level
+// heading titled heading replaced by the content of section. A section runs
+// until the next heading of the same or a higher level. The heading itself is
+// kept unless section starts with a heading of the same title, which then
+// replaces it. Titles match case-insensitively, and leading "#" markers in
+// heading are ignored, so "## Findings" matches a "Findings" heading.
+//
+// Returns ENotFound listing the document's headings if none matches, and
+// EConflict if more than one does.
+func ReplaceSection(doc jira4claude.ADF, heading string, section jira4claude.ADF) (jira4claude.ADF, error) {
+	title := normalizeTitle(heading)
+	content := contentOf(doc)
+
+	start := -1
+	var titles []string
+	for i, node := range content {
+		nodeTitle, ok := headingTitle(node)
+		if !ok {
+			continue
+		}
+		titles = append(titles, strconv.Quote(nodeTitle))
+		if normalizeTitle(nodeTitle) != title {
+			continue
+		}
+		if start >= 0 {
+			return nil, &jira4claude.Error{
+				Code:    jira4claude.EConflict,
+				Message: "description has more than one heading " + strconv.Quote(heading),
+			}
+		}
+		start = i
+	}
+	if start < 0 {
+		msg := "heading " + strconv.Quote(heading) + " not found in description"
+		if len(titles) > 0 {
+			msg += "; headings: " + strings.Join(titles, ", ")
+		}
+		return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+	}
+
+	level := headingLevel(content[start])
+	end := len(content)
+	for i := start + 1; i < len(content); i++ {
+		if _, ok := headingTitle(content[i]); ok && headingLevel(content[i]) <= level {
+			end = i
+			break
+		}
+	}
+
+	replacement := contentOf(section)
+	keep := start + 1
+	if len(replacement) > 0 {
+		if first, ok := headingTitle(replacement[0]); ok && normalizeTitle(first) == title {
+			keep = start
+		}
+	}
+	return withContent(doc, slices.Concat(content[:keep], replacement, content[end:])), nil
+}
+
+// contentOf returns the top-level content of doc, or nil if it has none.
+func contentOf(doc jira4claude.ADF) []any {
+	content, _ := doc["content"].([]any)
+	return content
+}
+
+// withContent returns a shallow copy of doc with its content replaced.
+// A nil doc yields a new empty document.
+func withContent(doc jira4claude.ADF, content []any) jira4claude.ADF {
+	result := jira4claude.ADF{"type": "doc", "version": 1}
+	for k, v := range doc {
+		result[k] = v
+	}
+	if content == nil {
+		content = []any{}
+	}
+	result["content"] = content
+	return result
+}
+
+// headingTitle returns the plain text of a heading node.
+func headingTitle(node any) (string, bool) {
+	m, ok := node.(map[string]any)
+	if !ok || typeOf(m) != "heading" {
+		return "", false
+	}
+	var b strings.Builder
+	collectText(m, &b)
+	return b.String(), true
+}
+
+// headingLevel returns the level of a heading node, defaulting to 1.
+func headingLevel(node any) int {
+	m, _ := node.(map[string]any)
+	if level, ok := number(attrsOf(m)["level"]); ok {
+		return level
+	}
+	return 1
+}
+
+// collectText appends the text of node and its descendants to b.
+func collectText(node map[string]any, b *strings.Builder) {
+	if text, ok := node["text"].(string); ok {
+		b.WriteString(text)
+	}
+	children, _ := node["content"].([]any)
+	for _, child := range children {
+		if m, ok := child.(map[string]any); ok {
+			collectText(m, b)
+		}
+	}
+}
+
+// normalizeTitle strips leading "#" markers and surrounding space and
+// lower-cases a heading title for comparison.
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(title), "#")))
+}
@@ -0,0 +1,132 @@
+package adf_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/adf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func heading(level int, s string) map[string]any {
+	return map[string]any{
+		"type":    "heading",
+		"attrs":   map[string]any{"level": level},
+		"content": []any{text(s)},
+	}
+}
+
+func TestAppend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds content after existing content", func(t *testing.T) {
+		t.Parallel()
+
+		original := doc(paragraph(text("first")))
+
+		got := adf.Append(original, doc(heading(2, "Findings"), paragraph(text("second"))))
+
+		assert.Equal(t, doc(paragraph(text("first")), heading(2, "Findings"), paragraph(text("second"))), got)
+		assert.Equal(t, doc(paragraph(text("first"))), original)
+	})
+
+	t.Run("treats missing description as empty", func(t *testing.T) {
+		t.Parallel()
+
+		got := adf.Append(nil, doc(paragraph(text("only"))))
+
+		assert.Equal(t, doc(paragraph(text("only"))), got)
+	})
+}
+
+func TestPrepend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds content before existing content", func(t *testing.T) {
+		t.Parallel()
+
+		got := adf.Prepend(doc(paragraph(text("body"))), doc(paragraph(text("note"))))
+
+		assert.Equal(t, doc(paragraph(text("note")), paragraph(text("body"))), got)
+	})
+}
+
+func TestReplaceSection(t *testing.T) {
+	t.Parallel()
+
+	// Preserves a node the markdown converter cannot represent
+	panel := map[string]any{"type": "panel", "attrs": map[string]any{"panelType": "info"}, "content": []any{paragraph(text("keep"))}}
+	original := func() jira4claude.ADF {
+		return doc(
+			panel,
+			heading(2, "Findings"),
+			paragraph(text("old")),
+			heading(3, "Details"),
+			paragraph(text("old details")),
+			heading(2, "Next steps"),
+			paragraph(text("unchanged")),
+		)
+	}
+
+	t.Run("replaces content up to the next heading of the same level", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := adf.ReplaceSection(original(), "Findings", doc(paragraph(text("new"))))
+
+		require.NoError(t, err)
+		assert.Equal(t, doc(
+			panel,
+			heading(2, "Findings"),
+			paragraph(text("new")),
+			heading(2, "Next steps"),
+			paragraph(text("unchanged")),
+		), got)
+	})
+
+	t.Run("replaces heading when section starts with the same title", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := adf.ReplaceSection(original(), "## findings", doc(heading(2, "Findings"), paragraph(text("new"))))
+
+		require.NoError(t, err)
+		assert.Equal(t, doc(
+			panel,
+			heading(2, "Findings"),
+			paragraph(text("new")),
+			heading(2, "Next steps"),
+			paragraph(text("unchanged")),
+		), got)
+	})
+
+	t.Run("replaces last section up to the end", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := adf.ReplaceSection(original(), "Next steps", doc(paragraph(text("done"))))
+
+		require.NoError(t, err)
+		assert.Equal(t, paragraph(text("done")), got["content"].([]any)[6])
+		assert.Len(t, got["content"], 7)
+	})
+
+	t.Run("returns not found listing headings", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.ReplaceSection(original(), "Summary", doc(paragraph(text("new"))))
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `"Findings", "Details", "Next steps"`)
+	})
+
+	t.Run("returns conflict when heading is ambiguous", func(t *testing.T) {
+		t.Parallel()
+
+		d := doc(heading(2, "Notes"), paragraph(text("a")), heading(2, "Notes"))
+
+		_, err := adf.ReplaceSection(d, "Notes", doc(paragraph(text("b"))))
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EConflict, jira4claude.ErrorCode(err))
+	})
+}
@@ -0,0 +1,162 @@
+package adf
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Part is a piece of a document picked out by Sections or Items.
+type Part struct {
+	Title string          // Heading or item text
+	Body  jira4claude.ADF // Content under the heading or nested in the item; nil if none
+}
+
+// Sections splits doc at its top-level headings of the given level. A section
+// runs until the next heading of the same or a higher level, so deeper
+// headings stay in its body. Content outside any section, such as an
+// introduction before the first heading, is dropped.
+func Sections(doc jira4claude.ADF, level int) []Part {
+	content := contentOf(doc)
+
+	var parts []Part
+	start := -1
+	var title string
+	flush := func(end int) {
+		if start >= 0 {
+			parts = append(parts, Part{Title: title, Body: bodyOf(content[start+1 : end])})
+		}
+		start = -1
+	}
+	for i, node := range content {
+		if _, ok := headingTitle(node); !ok || headingLevel(node) > level {
+			continue
+		}
+		flush(i)
+		if headingLevel(node) == level {
+			start, title = i, titleOf(node.(map[string]any))
+		}
+	}
+	flush(len(content))
+	return parts
+}
+
+// Items returns the items of the top-level bullet, ordered and task lists of
+// doc. An item's title is the text of its first paragraph, or of the task
+// for task items (see titleOf), and its body is the rest of its content, such as nested
+// lists. Completed task items are skipped along with their nested tasks.
+func Items(doc jira4claude.ADF) []Part {
+	var parts []Part
+	for _, node := range contentOf(doc) {
+		list, ok := node.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch typeOf(list) {
+		case "bulletList", "orderedList", "taskList":
+		default:
+			continue
+		}
+
+		// Index of the part that nested task lists belong to; -1 after a
+		// skipped item.
+		last := -1
+		children, _ := list["content"].([]any)
+		for _, child := range children {
+			item, ok := child.(map[string]any)
+			if !ok {
+				continue
+			}
+			switch typeOf(item) {
+			case "listItem":
+				parts = append(parts, listItemPart(item))
+				last = len(parts) - 1
+			case "taskItem":
+				if attrsOf(item)["state"] == "DONE" {
+					last = -1
+					continue
+				}
+				parts = append(parts, Part{Title: titleOf(item)})
+				last = len(parts) - 1
+			case "taskList":
+				// In ADF, subtasks follow their task as a sibling list.
+				if last >= 0 {
+					parts[last].Body = Append(parts[last].Body, withContent(nil, []any{item}))
+				}
+			}
+		}
+	}
+	return parts
+}
+
+// listItemPart returns the part for a list item whose first paragraph is
+// the title.
+func listItemPart(item map[string]any) Part {
+	children, _ := item["content"].([]any)
+	if len(children) == 0 {
+		return Part{}
+	}
+	if first, ok := children[0].(map[string]any); ok && typeOf(first) == "paragraph" {
+		return Part{Title: titleOf(first), Body: bodyOf(children[1:])}
+	}
+	return Part{Body: bodyOf(children)}
+}
+
+// bodyOf returns a document holding nodes, or nil if there are none.
+func bodyOf(nodes []any) jira4claude.ADF {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return withContent(nil, slices.Clone(nodes))
+}
+
+// titleOf returns node's text as Jira shows it, on one line: text nodes,
+// the names of mentions, emoji and status lozenges, the URLs of smart links
+// and dates as YYYY-MM-DD.
+func titleOf(node map[string]any) string {
+	var b strings.Builder
+	collectTitle(node, &b)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// collectTitle appends the shown text of node and its descendants to b.
+func collectTitle(node map[string]any, b *strings.Builder) {
+	attrs := attrsOf(node)
+	switch typeOf(node) {
+	case "mention", "status":
+		text, _ := attrs["text"].(string)
+		b.WriteString(text)
+	case "emoji":
+		text, _ := attrs["text"].(string)
+		shortName, _ := attrs["shortName"].(string)
+		b.WriteString(cmp.Or(text, shortName))
+	case "inlineCard":
+		url, _ := attrs["url"].(string)
+		b.WriteString(url)
+	case "date":
+		// The timestamp holds milliseconds since the Unix epoch, usually as a string.
+		millis, ok := number(attrs["timestamp"])
+		if ts, isString := attrs["timestamp"].(string); isString {
+			parsed, err := strconv.ParseInt(ts, 10, 64)
+			millis, ok = int(parsed), err == nil
+		}
+		if ok {
+			b.WriteString(time.UnixMilli(int64(millis)).UTC().Format("2006-01-02"))
+		}
+	case "hardBreak":
+		b.WriteString(" ")
+	}
+	if text, ok := node["text"].(string); ok {
+		b.WriteString(text)
+	}
+	children, _ := node["content"].([]any)
+	for _, child := range children {
+		if m, ok := child.(map[string]any); ok {
+			collectTitle(m, b)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package adf_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude/adf"
+	"github.com/stretchr/testify/assert"
+)
+
+func list(typ string, items ...any) map[string]any {
+	return map[string]any{"type": typ, "content": items}
+}
+
+func listItem(content ...any) map[string]any {
+	return map[string]any{"type": "listItem", "content": content}
+}
+
+func taskItem(state, s string) map[string]any {
+	return map[string]any{"type": "taskItem", "attrs": map[string]any{"state": state}, "content": []any{text(s)}}
+}
+
+func TestSections(t *testing.T) {
+	t.Parallel()
+
+	t.Run("splits at headings of the level and keeps deeper headings", func(t *testing.T) {
+		t.Parallel()
+
+		got := adf.Sections(doc(
+			paragraph(text("intro")),
+			heading(2, "Backend"),
+			paragraph(text("api")),
+			heading(3, "Details"),
+			paragraph(text("more")),
+			heading(2, " Frontend "),
+		), 2)
+
+		assert.Equal(t, []adf.Part{
+			{Title: "Backend", Body: doc(paragraph(text("api")), heading(3, "Details"), paragraph(text("more")))},
+			{Title: "Frontend"},
+		}, got)
+	})
+
+	t.Run("ends a section at a higher level heading", func(t *testing.T) {
+		t.Parallel()
+
+		got := adf.Sections(doc(
+			heading(2, "Backend"),
+			paragraph(text("api")),
+			heading(1, "Appendix"),
+			paragraph(text("notes")),
+		), 2)
+
+		assert.Equal(t, []adf.Part{
+			{Title: "Backend", Body: doc(paragraph(text("api")))},
+		}, got)
+	})
+
+	t.Run("returns nothing without headings", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, adf.Sections(doc(paragraph(text("only text"))), 2))
+		assert.Empty(t, adf.Sections(nil, 2))
+	})
+}
+
+func TestItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns top-level list items with nested content as body", func(t *testing.T) {
+		t.Parallel()
+
+		nested := list("bulletList", listItem(paragraph(text("detail"))))
+		got := adf.Items(doc(
+			paragraph(text("intro")),
+			list("bulletList",
+				listItem(paragraph(text("Add "), text("endpoint", map[string]any{"type": "code"})), nested),
+				listItem(paragraph(text("Write docs"))),
+			),
+			list("orderedList", listItem(paragraph(text("Release")))),
+		))
+
+		assert.Equal(t, []adf.Part{
+			{Title: "Add endpoint", Body: doc(nested)},
+			{Title: "Write docs"},
+			{Title: "Release"},
+		}, got)
+	})
+
+	t.Run("skips done task items along with their nested tasks", func(t *testing.T) {
+		t.Parallel()
+
+		nested := list("taskList", taskItem("TODO", "sub-step"))
+		got := adf.Items(doc(list("taskList",
+			taskItem("TODO", "Migrate"),
+			nested,
+			taskItem("DONE", "Design"),
+			list("taskList", taskItem("TODO", "done sub-step")),
+			taskItem("TODO", "Clean up"),
+		)))
+
+		assert.Equal(t, []adf.Part{
+			{Title: "Migrate", Body: doc(nested)},
+			{Title: "Clean up"},
+		}, got)
+	})
+
+	t.Run("titles items with the text shown for inline nodes", func(t *testing.T) {
+		t.Parallel()
+
+		got := adf.Items(doc(list("bulletList",
+			listItem(paragraph(map[string]any{"type": "inlineCard", "attrs": map[string]any{"url": "https://example.atlassian.net/browse/PROJ-1"}})),
+			listItem(paragraph(
+				text("Ask "),
+				map[string]any{"type": "mention", "attrs": map[string]any{"id": "acc-1", "text": "@Jane"}},
+				text(" by "),
+				map[string]any{"type": "date", "attrs": map[string]any{"timestamp": "1767225600000"}},
+			)),
+		)))
+
+		assert.Equal(t, []adf.Part{
+			{Title: "https://example.atlassian.net/browse/PROJ-1"},
+			{Title: "Ask @Jane by 2026-01-01"},
+		}, got)
+	})
+
+	t.Run("returns nothing without lists", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, adf.Items(doc(heading(2, "Plan"), paragraph(text("text")))))
+	})
+}
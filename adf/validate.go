@@ -0,0 +1,288 @@
+// Package adf validates Atlassian Document Format documents against the
+// published ADF schema, and splices documents at the node level.
+//
+// Validation runs before documents are sent to Jira, so structural problems
+// surface as actionable errors pointing at the offending node instead of a
+// generic 400 response. The package has no dependency on the markdown
+// converter, which makes it usable as an independent oracle in tests.
+package adf
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// maxProblems limits how many problems are reported in a single error.
+const maxProblems = 5
+
+// Validate checks doc against the ADF schema: node nesting, required attrs,
+// and mark compatibility. It returns an EValidation error listing each problem
+// with the path to the offending node (e.g., "content[0].content[2].marks[1]"),
+// or nil for a valid document.
+//
+// Node and mark types missing from the schema, such as ones added to Jira
+// after it was written, are reported as warnings and sent as they are.
+func Validate(doc jira4claude.ADF) ([]string, error) {
+	v := &validator{}
+	v.validateDoc(doc)
+	if len(v.problems) == 0 {
+		return v.warnings, nil
+	}
+
+	problems := v.problems
+	if len(problems) > maxProblems {
+		problems = append(problems[:maxProblems:maxProblems], fmt.Sprintf("and %d more", len(v.problems)-maxProblems))
+	}
+	return v.warnings, &jira4claude.Error{
+		Code:    jira4claude.EValidation,
+		Message: "invalid ADF: " + strings.Join(problems, "; "),
+	}
+}
+
+// validator accumulates schema problems and warnings while walking a document.
+type validator struct {
+	problems []string
+	warnings []string
+}
+
+func (v *validator) addf(path, format string, args ...any) {
+	v.problems = append(v.problems, located(path, format, args...))
+}
+
+func (v *validator) warnf(path, format string, args ...any) {
+	v.warnings = append(v.warnings, located(path, format, args...))
+}
+
+// located prefixes a message with the path it refers to.
+func located(path, format string, args ...any) string {
+	if path == "" {
+		path = "doc"
+	}
+	return path + ": " + fmt.Sprintf(format, args...)
+}
+
+// validateDoc checks the root node.
+func (v *validator) validateDoc(doc map[string]any) {
+	if doc == nil {
+		v.addf("", "document is empty")
+		return
+	}
+	if doc["type"] != "doc" {
+		v.addf("", `root node type must be "doc", got %q`, typeOf(doc))
+		return
+	}
+	if version, ok := number(doc["version"]); !ok || version != 1 {
+		v.addf("", "version must be 1")
+	}
+	v.validateContent(doc, schema["doc"], "")
+}
+
+// validateNode checks a single node and recurses into its content.
+func (v *validator) validateNode(node map[string]any, parentType string, allowed []string, textMarks []string, path string) {
+	nodeType := typeOf(node)
+	if nodeType == "" {
+		v.addf(path, "node is missing a type")
+		return
+	}
+
+	spec, known := schema[nodeType]
+	if !known {
+		v.warnf(path, "unknown node type %q was not checked", nodeType)
+		return
+	}
+	if !slices.Contains(allowed, nodeType) {
+		v.addf(path, "node type %q is not allowed in %s", nodeType, parentType)
+		return
+	}
+
+	v.validateAttrs(node, nodeType, spec.required, spec.requiredOneOf, spec.enums, path)
+
+	if nodeType == "text" {
+		if text, _ := node["text"].(string); text == "" {
+			v.addf(path, "text node must have non-empty text")
+		}
+	}
+	if nodeType == "heading" {
+		if level, ok := number(attrsOf(node)["level"]); ok && (level < 1 || level > 6) {
+			v.addf(path, "heading level must be between 1 and 6")
+		}
+	}
+
+	markRules := spec.marks
+	if nodeType == "text" && textMarks != nil {
+		markRules = textMarks
+	}
+	v.validateMarks(node, nodeType, markRules, path)
+	v.validateContent(node, spec, path)
+}
+
+// validateContent checks the children of a node.
+func (v *validator) validateContent(node map[string]any, spec nodeSpec, path string) {
+	nodeType := typeOf(node)
+	raw, hasContent := node["content"]
+	if !hasContent || raw == nil {
+		if spec.minContent > 0 {
+			v.addf(path, "%s must have content", nodeType)
+		}
+		return
+	}
+
+	content, ok := raw.([]any)
+	if !ok {
+		v.addf(path, "content must be an array")
+		return
+	}
+	if spec.content == nil && len(content) > 0 {
+		v.addf(path, "%s must not have content", nodeType)
+		return
+	}
+	if len(content) < spec.minContent {
+		v.addf(path, "%s must have at least %d child node(s)", nodeType, spec.minContent)
+	}
+
+	for i, item := range content {
+		childPath := join(path, fmt.Sprintf("content[%d]", i))
+		child, ok := item.(map[string]any)
+		if !ok {
+			v.addf(childPath, "node must be an object")
+			continue
+		}
+		if i == 0 && spec.first != nil && !slices.Contains(spec.first, typeOf(child)) {
+			v.addf(childPath, "first child of %s must be one of %s, got %q", nodeType, strings.Join(spec.first, ", "), typeOf(child))
+			continue
+		}
+		v.validateNode(child, nodeType, spec.content, spec.textMarks, childPath)
+	}
+}
+
+// validateAttrs checks required and enumerated attrs of a node or mark.
+func (v *validator) validateAttrs(node map[string]any, name string, required, requiredOneOf []string, enums map[string][]string, path string) {
+	attrs := attrsOf(node)
+	for _, attr := range required {
+		if isEmpty(attrs[attr]) {
+			v.addf(path, "%s requires attr %q", name, attr)
+		}
+	}
+	if len(requiredOneOf) > 0 {
+		found := false
+		for _, attr := range requiredOneOf {
+			if !isEmpty(attrs[attr]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.addf(path, "%s requires one of attrs %s", name, strings.Join(requiredOneOf, ", "))
+		}
+	}
+	// Sort enum keys for deterministic problem order
+	keys := make([]string, 0, len(enums))
+	for attr := range enums {
+		keys = append(keys, attr)
+	}
+	slices.Sort(keys)
+	for _, attr := range keys {
+		value, ok := attrs[attr].(string)
+		if !ok {
+			continue
+		}
+		if !slices.Contains(enums[attr], value) {
+			v.addf(path, "%s attr %q must be one of %s, got %q", name, attr, strings.Join(enums[attr], ", "), value)
+		}
+	}
+}
+
+// validateMarks checks that each mark is known, allowed on the node,
+// has its required attrs, and is compatible with the other marks.
+func (v *validator) validateMarks(node map[string]any, nodeType string, allowed []string, path string) {
+	raw, ok := node["marks"]
+	if !ok || raw == nil {
+		return
+	}
+	list, ok := raw.([]any)
+	if !ok {
+		v.addf(path, "marks must be an array")
+		return
+	}
+
+	seen := make(map[string]bool, len(list))
+	for i, item := range list {
+		markPath := join(path, fmt.Sprintf("marks[%d]", i))
+		mark, ok := item.(map[string]any)
+		if !ok {
+			v.addf(markPath, "mark must be an object")
+			continue
+		}
+		markType := typeOf(mark)
+		spec, known := marks[markType]
+		if !known {
+			v.warnf(markPath, "unknown mark type %q was not checked", markType)
+			continue
+		}
+		if !slices.Contains(allowed, markType) {
+			v.addf(markPath, "mark %q is not allowed on %s", markType, nodeType)
+			continue
+		}
+		if seen[markType] {
+			v.addf(markPath, "duplicate mark %q", markType)
+			continue
+		}
+		seen[markType] = true
+		v.validateAttrs(mark, markType+" mark", spec.required, nil, spec.enums, markPath)
+	}
+
+	for i, item := range list {
+		mark, _ := item.(map[string]any)
+		markType := typeOf(mark)
+		for _, excluded := range marks[markType].excludes {
+			if seen[excluded] {
+				v.addf(join(path, fmt.Sprintf("marks[%d]", i)), "mark %q cannot be combined with %q", markType, excluded)
+			}
+		}
+	}
+}
+
+// typeOf returns the type of a node or mark, or "" if missing.
+func typeOf(node map[string]any) string {
+	nodeType, _ := node["type"].(string)
+	return nodeType
+}
+
+// attrsOf returns the attrs of a node or mark, or nil if missing.
+func attrsOf(node map[string]any) map[string]any {
+	attrs, _ := node["attrs"].(map[string]any)
+	return attrs
+}
+
+// isEmpty reports whether an attr value is missing or an empty string.
+func isEmpty(value any) bool {
+	if value == nil {
+		return true
+	}
+	s, ok := value.(string)
+	return ok && s == ""
+}
+
+// number converts a JSON or Go numeric value to an int.
+// JSON-decoded numbers are float64; converter-built numbers are int.
+func number(value any) (int, bool) {
+	switch n := value.(type) {
+	case int:
+		return n, true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// join appends a segment to a dotted path.
+func join(path, segment string) string {
+	if path == "" {
+		return segment
+	}
+	return path + "." + segment
+}
@@ -0,0 +1,255 @@
+package adf_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/adf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doc(content ...any) jira4claude.ADF {
+	return jira4claude.ADF{
+		"type":    "doc",
+		"version": 1,
+		"content": content,
+	}
+}
+
+func paragraph(content ...any) map[string]any {
+	return map[string]any{"type": "paragraph", "content": content}
+}
+
+func text(s string, marks ...any) map[string]any {
+	node := map[string]any{"type": "text", "text": s}
+	if len(marks) > 0 {
+		node["marks"] = marks
+	}
+	return node
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts a valid document", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			map[string]any{
+				"type":    "heading",
+				"attrs":   map[string]any{"level": 2},
+				"content": []any{text("Title", map[string]any{"type": "strong"})},
+			},
+			paragraph(
+				text("See "),
+				text("docs", map[string]any{"type": "link", "attrs": map[string]any{"href": "https://example.com"}}),
+				map[string]any{"type": "hardBreak"},
+				map[string]any{"type": "status", "attrs": map[string]any{"text": "Done", "color": "green"}},
+			),
+			map[string]any{
+				"type": "bulletList",
+				"content": []any{
+					map[string]any{
+						"type": "listItem",
+						"content": []any{
+							paragraph(text("item")),
+							map[string]any{
+								"type":    "orderedList",
+								"content": []any{map[string]any{"type": "listItem", "content": []any{paragraph(text("nested"))}}},
+							},
+						},
+					},
+				},
+			},
+			map[string]any{
+				"type":    "codeBlock",
+				"attrs":   map[string]any{"language": "go"},
+				"content": []any{text("fmt.Println()")},
+			},
+		))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("accepts JSON-decoded numbers", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(jira4claude.ADF{
+			"type":    "doc",
+			"version": float64(1),
+			"content": []any{
+				map[string]any{
+					"type":    "heading",
+					"attrs":   map[string]any{"level": float64(3)},
+					"content": []any{text("Title")},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects non-doc root", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(jira4claude.ADF{"type": "paragraph"})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `root node type must be "doc"`)
+	})
+
+	t.Run("reports path to block nested in inline context", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			paragraph(text("ok")),
+			paragraph(text("a"), paragraph(text("b"))),
+		))
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `content[1].content[1]: node type "paragraph" is not allowed in paragraph`)
+	})
+
+	t.Run("rejects list item starting with a nested list", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			map[string]any{
+				"type": "bulletList",
+				"content": []any{
+					map[string]any{
+						"type": "listItem",
+						"content": []any{
+							map[string]any{"type": "bulletList", "content": []any{
+								map[string]any{"type": "listItem", "content": []any{paragraph(text("x"))}},
+							}},
+						},
+					},
+				},
+			},
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "content[0].content[0].content[0]: first child of listItem")
+	})
+
+	t.Run("rejects missing required attrs", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			map[string]any{"type": "heading", "content": []any{text("x")}},
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `content[0]: heading requires attr "level"`)
+	})
+
+	t.Run("rejects link mark without href", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			paragraph(text("x", map[string]any{"type": "link", "attrs": map[string]any{}})),
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `content[0].content[0].marks[0]: link mark requires attr "href"`)
+	})
+
+	t.Run("rejects code mark combined with strong", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			paragraph(text("x", map[string]any{"type": "code"}, map[string]any{"type": "strong"})),
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `mark "code" cannot be combined with "strong"`)
+	})
+
+	t.Run("rejects marks on code block text", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			map[string]any{
+				"type":    "codeBlock",
+				"content": []any{text("x", map[string]any{"type": "strong"})},
+			},
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `content[0].content[0].marks[0]: mark "strong" is not allowed on text`)
+	})
+
+	t.Run("rejects empty text and empty lists", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			paragraph(text("")),
+			map[string]any{"type": "bulletList", "content": []any{}},
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "content[0].content[0]: text node must have non-empty text")
+		assert.Contains(t, err.Error(), "content[1]: bulletList must have at least 1 child node(s)")
+	})
+
+	t.Run("rejects invalid enum values", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adf.Validate(doc(
+			paragraph(map[string]any{"type": "status", "attrs": map[string]any{"text": "x", "color": "pink"}}),
+		))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `status attr "color" must be one of`)
+	})
+
+	t.Run("accepts captioned media", func(t *testing.T) {
+		t.Parallel()
+
+		warnings, err := adf.Validate(doc(
+			map[string]any{
+				"type":  "mediaSingle",
+				"attrs": map[string]any{"layout": "center"},
+				"content": []any{
+					map[string]any{"type": "media", "attrs": map[string]any{"id": "abc", "type": "file", "collection": "jira"}},
+					map[string]any{"type": "caption", "content": []any{text("Screenshot")}},
+				},
+			},
+		))
+
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("warns about unknown node and mark types", func(t *testing.T) {
+		t.Parallel()
+
+		warnings, err := adf.Validate(doc(
+			map[string]any{"type": "sparkle", "content": []any{"anything"}},
+			paragraph(text("x", map[string]any{"type": "glow"})),
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`content[0]: unknown node type "sparkle" was not checked`,
+			`content[1].content[0].marks[0]: unknown mark type "glow" was not checked`,
+		}, warnings)
+	})
+
+	t.Run("limits the number of reported problems", func(t *testing.T) {
+		t.Parallel()
+
+		content := make([]any, 8)
+		for i := range content {
+			content[i] = map[string]any{"type": "heading", "content": []any{text("x")}}
+		}
+		_, err := adf.Validate(doc(content...))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "and 3 more")
+	})
+}
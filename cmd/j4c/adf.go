@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// readADFFile reads a raw ADF document from a JSON file.
+// The document is sent to Jira as-is, bypassing markdown conversion.
+func readADFFile(path string) (jira4claude.ADF, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "failed to read ADF file " + path,
+			Inner:   err,
+		}
+	}
+
+	var doc jira4claude.ADF
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "invalid JSON in ADF file " + path,
+			Inner:   err,
+		}
+	}
+	if doc["type"] != "doc" {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: `ADF file ` + path + ` must contain a document with "type": "doc"`,
+		}
+	}
+
+	return doc, nil
+}
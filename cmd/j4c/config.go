@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// ConfigCmd groups config operations.
+type ConfigCmd struct {
+	Show     ConfigShowCmd     `cmd:"" help:"Show effective config (J4C_* environment variables override the selected profile, which overrides config files)"`
+	Get      ConfigGetCmd      `cmd:"" help:"Get a config value"`
+	Set      ConfigSetCmd      `cmd:"" help:"Set a config value"`
+	Unset    ConfigUnsetCmd    `cmd:"" help:"Remove a config value"`
+	Validate ConfigValidateCmd `cmd:"" help:"Check config files for errors"`
+	Doctor   ConfigDoctorCmd   `cmd:"" help:"Check credentials and connectivity"`
+	Profiles ConfigProfilesCmd `cmd:"" help:"List named profiles"`
+}
+
+// ConfigShowCmd shows the effective config merged from all config files,
+// the selected profile and the environment, in increasing precedence.
+type ConfigShowCmd struct {
+	Origin bool `help:"Show which file or environment variable each value came from"`
+}
+
+// Run executes the config show command.
+func (c *ConfigShowCmd) Run(ctx *ConfigContext) error {
+	settings, err := ctx.Service.Show(ctx.Paths, ctx.Profile)
+	if err != nil {
+		return err
+	}
+
+	if !c.Origin {
+		for _, s := range settings {
+			s.Origin = ""
+		}
+	}
+	ctx.Printer.Settings(settings)
+	return nil
+}
+
+// ConfigGetCmd prints the effective value of a config key.
+type ConfigGetCmd struct {
+	Key string `arg:"" help:"Config key (e.g., server, profiles.web.project)"`
+}
+
+// Run executes the config get command.
+func (c *ConfigGetCmd) Run(ctx *ConfigContext) error {
+	setting, err := ctx.Service.Get(ctx.Paths, ctx.Profile, c.Key)
+	if err != nil {
+		return err
+	}
+	ctx.Printer.Settings([]*jira4claude.ConfigSetting{setting})
+	return nil
+}
+
+// ConfigSetCmd sets a config key in a config file.
+type ConfigSetCmd struct {
+	Key   string `arg:"" help:"Config key (e.g., server, profiles.web.project)"`
+	Value string `arg:"" help:"Value to set"`
+	File  string `help:"Config file to modify (default: the highest-precedence config file)" type:"path"`
+}
+
+// Run executes the config set command.
+func (c *ConfigSetCmd) Run(ctx *ConfigContext) error {
+	path, err := targetFile(ctx, c.File)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Service.Set(path, c.Key, c.Value); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Set " + c.Key + " in " + path)
+	return nil
+}
+
+// ConfigUnsetCmd removes a config key from a config file.
+type ConfigUnsetCmd struct {
+	Key  string `arg:"" help:"Config key (e.g., output, profiles.web.project)"`
+	File string `help:"Config file to modify (default: the highest-precedence config file)" type:"path"`
+}
+
+// Run executes the config unset command.
+func (c *ConfigUnsetCmd) Run(ctx *ConfigContext) error {
+	path, err := targetFile(ctx, c.File)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Service.Unset(path, c.Key); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Unset " + c.Key + " in " + path)
+	return nil
+}
+
+// targetFile returns the file set/unset should modify: the explicit file if
+// given, otherwise the highest-precedence config file.
+func targetFile(ctx *ConfigContext, file string) (string, error) {
+	if file != "" {
+		return file, nil
+	}
+	if len(ctx.Paths) == 0 {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.ENotFound,
+			Message: "no config file to modify; use --file or run j4c init",
+		}
+	}
+	return ctx.Paths[len(ctx.Paths)-1], nil
+}
+
+// ConfigValidateCmd checks config files for unknown keys and invalid values.
+type ConfigValidateCmd struct{}
+
+// Run executes the config validate command.
+func (c *ConfigValidateCmd) Run(ctx *ConfigContext) error {
+	if err := ctx.Service.Validate(ctx.Paths, ctx.Profile); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Config is valid (" + strconv.Itoa(len(ctx.Paths)) + " file(s))")
+	return nil
+}
+
+// ConfigDoctorCmd checks that the config can be used to talk to Jira.
+type ConfigDoctorCmd struct{}
+
+// Run executes the config doctor command.
+// It prints every check and returns an error carrying the code of the first
+// failed check, so the exit code reflects what went wrong.
+func (c *ConfigDoctorCmd) Run(ctx *ConfigContext) error {
+	var checks []*jira4claude.Check
+	cfg, err := ctx.Service.Load(ctx.Paths, ctx.Profile)
+	if err != nil {
+		checks = append(checks, &jira4claude.Check{
+			Name:    "config",
+			Code:    jira4claude.ErrorCode(err),
+			Message: jira4claude.ErrorMessage(err),
+		})
+	} else {
+		checks = append(checks, &jira4claude.Check{
+			Name:    "config",
+			Message: "loaded from " + strconv.Itoa(len(ctx.Paths)) + " file(s)",
+		})
+		checks = append(checks, ctx.Diagnostics.Diagnose(context.Background(), cfg)...)
+	}
+
+	ctx.Printer.Checks(checks)
+
+	var failed []*jira4claude.Check
+	for _, check := range checks {
+		if check.Code != "" {
+			failed = append(failed, check)
+		}
+	}
+	if len(failed) > 0 {
+		return &jira4claude.Error{
+			Code:    failed[0].Code,
+			Message: fmt.Sprintf("%d check(s) failed; first: %s", len(failed), failed[0].Name),
+		}
+	}
+	return nil
+}
+
+// ConfigProfilesCmd lists the named profiles in the config file.
+type ConfigProfilesCmd struct{}
+
+// Run executes the config profiles command.
+// The profile selected by --profile (or J4C_PROFILE) is marked active,
+// falling back to the file's default profile.
+func (c *ConfigProfilesCmd) Run(ctx *ConfigContext) error {
+	profiles, err := ctx.Service.Profiles(ctx.Paths)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range profiles {
+		if ctx.Profile != "" {
+			p.Active = p.Name == ctx.Profile
+		} else {
+			p.Active = p.Default
+		}
+	}
+	ctx.Printer.Profiles(profiles)
+	return nil
+}
@@ -0,0 +1,357 @@
+package main_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	main "github.com/fwojciec/jira4claude/cmd/j4c"
+	"github.com/fwojciec/jira4claude/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigShowCmd(t *testing.T) {
+	t.Parallel()
+
+	settings := func() []*jira4claude.ConfigSetting {
+		return []*jira4claude.ConfigSetting{
+			{Key: "server", Value: "https://example.atlassian.net", Origin: "/home/me/.jira4claude.yaml"},
+			{Key: "project", Value: "TEST", Origin: "/repo/.jira4claude.yaml"},
+		}
+	}
+
+	t.Run("passes paths and profile to the service and hides origins", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedPaths []string
+		var capturedProfile string
+		svc := &mock.ConfigService{
+			ShowFn: func(paths []string, profile string) ([]*jira4claude.ConfigSetting, error) {
+				capturedPaths = paths
+				capturedProfile = profile
+				return settings(), nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Paths: []string{"/repo/.jira4claude.yaml"}, Profile: "web"}
+
+		err := (&main.ConfigShowCmd{}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/repo/.jira4claude.yaml"}, capturedPaths)
+		assert.Equal(t, "web", capturedProfile)
+		require.Len(t, printer.SettingsCalls, 1)
+		require.Len(t, printer.SettingsCalls[0], 2)
+		assert.Empty(t, printer.SettingsCalls[0][0].Origin)
+		assert.Empty(t, printer.SettingsCalls[0][1].Origin)
+	})
+
+	t.Run("keeps origins with --origin", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			ShowFn: func(paths []string, profile string) ([]*jira4claude.ConfigSetting, error) {
+				return settings(), nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer}
+
+		err := (&main.ConfigShowCmd{Origin: true}).Run(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, printer.SettingsCalls, 1)
+		assert.Equal(t, "/home/me/.jira4claude.yaml", printer.SettingsCalls[0][0].Origin)
+		assert.Equal(t, "/repo/.jira4claude.yaml", printer.SettingsCalls[0][1].Origin)
+	})
+}
+
+func TestConfigGetCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("prints the setting for the key", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedKey string
+		svc := &mock.ConfigService{
+			GetFn: func(paths []string, profile, key string) (*jira4claude.ConfigSetting, error) {
+				capturedKey = key
+				return &jira4claude.ConfigSetting{Key: key, Value: "TEST"}, nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer}
+
+		err := (&main.ConfigGetCmd{Key: "project"}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "project", capturedKey)
+		require.Len(t, printer.SettingsCalls, 1)
+		assert.Equal(t, "TEST", printer.SettingsCalls[0][0].Value)
+	})
+}
+
+func TestConfigSetCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes to the highest-precedence file by default", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedPath, capturedKey, capturedValue string
+		svc := &mock.ConfigService{
+			SetFn: func(path, key, value string) error {
+				capturedPath, capturedKey, capturedValue = path, key, value
+				return nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Paths: []string{"/home/.jira4claude.yaml", "/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigSetCmd{Key: "output", Value: "json"}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "/repo/.jira4claude.yaml", capturedPath)
+		assert.Equal(t, "output", capturedKey)
+		assert.Equal(t, "json", capturedValue)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Set output in /repo/.jira4claude.yaml", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("writes to the file given with --file", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedPath string
+		svc := &mock.ConfigService{
+			SetFn: func(path, key, value string) error {
+				capturedPath = path
+				return nil
+			},
+		}
+		ctx := &main.ConfigContext{Service: svc, Printer: &mock.Printer{}, Paths: []string{"/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigSetCmd{Key: "output", Value: "json", File: "/home/.jira4claude.yaml"}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "/home/.jira4claude.yaml", capturedPath)
+	})
+}
+
+func TestConfigUnsetCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes the key and reports success", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedPath, capturedKey string
+		svc := &mock.ConfigService{
+			UnsetFn: func(path, key string) error {
+				capturedPath, capturedKey = path, key
+				return nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Paths: []string{"/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigUnsetCmd{Key: "output"}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "/repo/.jira4claude.yaml", capturedPath)
+		assert.Equal(t, "output", capturedKey)
+		require.Len(t, printer.SuccessCalls, 1)
+	})
+
+	t.Run("returns not found without a config file", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.ConfigContext{Service: &mock.ConfigService{}, Printer: &mock.Printer{}}
+
+		err := (&main.ConfigUnsetCmd{Key: "output"}).Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestConfigValidateCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("prints success for a valid config", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			ValidateFn: func(paths []string, profile string) error { return nil },
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Paths: []string{"/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigValidateCmd{}).Run(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Config is valid (1 file(s))", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("returns validation error", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			ValidateFn: func(paths []string, profile string) error {
+				return &jira4claude.Error{Code: jira4claude.EValidation, Message: "invalid config"}
+			},
+		}
+		ctx := &main.ConfigContext{Service: svc, Printer: &mock.Printer{}}
+
+		err := (&main.ConfigValidateCmd{}).Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestConfigDoctorCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("prints config check followed by diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &jira4claude.Config{Server: "https://example.atlassian.net", Project: "TEST"}
+		svc := &mock.ConfigService{
+			LoadFn: func(paths []string, profile string) (*jira4claude.Config, error) { return cfg, nil },
+		}
+		var diagnosed *jira4claude.Config
+		diag := &mock.DiagnosticService{
+			DiagnoseFn: func(_ context.Context, c *jira4claude.Config) []*jira4claude.Check {
+				diagnosed = c
+				return []*jira4claude.Check{{Name: "auth", Message: "authenticated as Jane"}}
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Diagnostics: diag, Printer: printer, Paths: []string{"/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigDoctorCmd{}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Same(t, cfg, diagnosed)
+		require.Len(t, printer.ChecksCalls, 1)
+		require.Len(t, printer.ChecksCalls[0], 2)
+		assert.Equal(t, "config", printer.ChecksCalls[0][0].Name)
+		assert.Equal(t, "auth", printer.ChecksCalls[0][1].Name)
+	})
+
+	t.Run("returns error with the code of the first failed check", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			LoadFn: func(paths []string, profile string) (*jira4claude.Config, error) {
+				return &jira4claude.Config{}, nil
+			},
+		}
+		diag := &mock.DiagnosticService{
+			DiagnoseFn: func(context.Context, *jira4claude.Config) []*jira4claude.Check {
+				return []*jira4claude.Check{
+					{Name: "credentials", Message: "ok"},
+					{Name: "auth", Code: jira4claude.EUnauthorized, Message: "401"},
+					{Name: "project", Skipped: true},
+				}
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Diagnostics: diag, Printer: printer}
+
+		err := (&main.ConfigDoctorCmd{}).Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EUnauthorized, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "auth")
+		require.Len(t, printer.ChecksCalls, 1)
+	})
+
+	t.Run("reports config errors without running diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			LoadFn: func(paths []string, profile string) (*jira4claude.Config, error) {
+				return nil, &jira4claude.Error{Code: jira4claude.EValidation, Message: "config file missing required field: server"}
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Diagnostics: &mock.DiagnosticService{}, Printer: printer}
+
+		err := (&main.ConfigDoctorCmd{}).Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		require.Len(t, printer.ChecksCalls, 1)
+		require.Len(t, printer.ChecksCalls[0], 1)
+		assert.Equal(t, jira4claude.EValidation, printer.ChecksCalls[0][0].Code)
+	})
+}
+
+func TestConfigProfilesCmd(t *testing.T) {
+	t.Parallel()
+
+	profiles := func() []*jira4claude.Profile {
+		return []*jira4claude.Profile{
+			{Name: "ops", Server: "https://one.atlassian.net", Project: "OPS", Default: true},
+			{Name: "web", Server: "https://two.atlassian.net", Project: "WEB"},
+		}
+	}
+
+	t.Run("lists profiles from the config path marking the default active", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedPaths []string
+		svc := &mock.ConfigService{
+			ProfilesFn: func(paths []string) ([]*jira4claude.Profile, error) {
+				capturedPaths = paths
+				return profiles(), nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Paths: []string{"/repo/.jira4claude.yaml"}}
+
+		err := (&main.ConfigProfilesCmd{}).Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/repo/.jira4claude.yaml"}, capturedPaths)
+		require.Len(t, printer.ProfilesCalls, 1)
+		assert.True(t, printer.ProfilesCalls[0][0].Active)
+		assert.False(t, printer.ProfilesCalls[0][1].Active)
+	})
+
+	t.Run("marks the selected profile active", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			ProfilesFn: func(paths []string) ([]*jira4claude.Profile, error) {
+				return profiles(), nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.ConfigContext{Service: svc, Printer: printer, Profile: "web"}
+
+		err := (&main.ConfigProfilesCmd{}).Run(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, printer.ProfilesCalls, 1)
+		assert.False(t, printer.ProfilesCalls[0][0].Active)
+		assert.True(t, printer.ProfilesCalls[0][1].Active)
+	})
+
+	t.Run("returns service error", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.ConfigService{
+			ProfilesFn: func(paths []string) ([]*jira4claude.Profile, error) {
+				return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "config file not found"}
+			},
+		}
+		ctx := &main.ConfigContext{Service: svc, Printer: &mock.Printer{}}
+
+		err := (&main.ConfigProfilesCmd{}).Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/adf"
 )
 
 // IssueCmd groups issue subcommands.
@@ -13,16 +19,26 @@ type IssueCmd struct {
 	List        IssueListCmd        `cmd:"" help:"List issues"`
 	Ready       IssueReadyCmd       `cmd:"" help:"List issues ready to work on"`
 	Create      IssueCreateCmd      `cmd:"" help:"Create an issue"`
+	Clone       IssueCloneCmd       `cmd:"" help:"Copy an issue, optionally with its subtasks and links"`
+	Split       IssueSplitCmd       `cmd:"" help:"Turn the checklist items or sections of an issue's description into subtasks"`
 	Update      IssueUpdateCmd      `cmd:"" help:"Update an issue"`
 	Transitions IssueTransitionsCmd `cmd:"" help:"List available transitions"`
 	Transition  IssueTransitionCmd  `cmd:"" help:"Transition an issue"`
+	Start       IssueStartCmd       `cmd:"" help:"Assign an issue to yourself and move it to In Progress"`
+	Done        IssueDoneCmd        `cmd:"" help:"Move an issue to a done status"`
 	Assign      IssueAssignCmd      `cmd:"" help:"Assign an issue"`
+	Watch       IssueWatchCmd       `cmd:"" help:"Add a watcher to an issue"`
+	Unwatch     IssueUnwatchCmd     `cmd:"" help:"Remove a watcher from an issue"`
+	Watchers    IssueWatchersCmd    `cmd:"" help:"List the watchers of an issue"`
+	Vote        IssueVoteCmd        `cmd:"" help:"Vote for an issue"`
+	Unvote      IssueUnvoteCmd      `cmd:"" help:"Remove your vote from an issue"`
 	Comment     IssueCommentCmd     `cmd:"" help:"Add a comment to an issue"`
 }
 
 // IssueViewCmd views an issue.
 type IssueViewCmd struct {
-	Key string `arg:"" help:"Issue key (e.g., PROJ-123)"`
+	Key    string `arg:"" help:"Issue key (e.g., PROJ-123)"`
+	RawADF bool   `help:"Include the untouched ADF description and comment bodies (JSON output)" name:"raw-adf"`
 }
 
 // Run executes the view command.
@@ -31,7 +47,17 @@ func (c *IssueViewCmd) Run(ctx *IssueContext) error {
 	if err != nil {
 		return err
 	}
+	// Web links are extra detail, so the issue is still shown without them.
+	if issue.RemoteLinks, err = ctx.Service.RemoteLinks(context.Background(), c.Key); err != nil {
+		ctx.Printer.Warning("failed to load web links: " + err.Error())
+	}
 	view := jira4claude.ToIssueView(issue, ctx.Converter, ctx.Printer.Warning, ctx.Config.Server)
+	if c.RawADF {
+		view.DescriptionADF = issue.Description
+		for i, comment := range issue.Comments {
+			view.Comments[i].BodyADF = comment.Body
+		}
+	}
 	ctx.Printer.Issue(view)
 	return nil
 }
@@ -44,6 +70,8 @@ type IssueListCmd struct {
 	Assignee      string   `help:"Filter by assignee (use 'me' for current user)" short:"a"`
 	Parent        string   `help:"Filter by parent issue" short:"P"`
 	Labels        []string `help:"Filter by labels" short:"l"`
+	Component     string   `help:"Filter by component" short:"c"`
+	FixVersion    string   `help:"Filter by fix version" name:"fix-version"`
 	OrderBy       string   `help:"Order results (e.g., 'created DESC')" name:"order-by"`
 	JQL           string   `help:"Raw JQL query (overrides other filters)"`
 	Limit         int      `help:"Maximum number of results" default:"50"`
@@ -58,6 +86,8 @@ func (c *IssueListCmd) Run(ctx *IssueContext) error {
 		Assignee:      c.Assignee,
 		Parent:        c.Parent,
 		Labels:        c.Labels,
+		Component:     c.Component,
+		FixVersion:    c.FixVersion,
 		OrderBy:       c.OrderBy,
 		JQL:           c.JQL,
 		Limit:         c.Limit,
@@ -115,14 +145,25 @@ func (c *IssueReadyCmd) Run(ctx *IssueContext) error {
 }
 
 // IssueCreateCmd creates an issue.
+// Unset flags fall back to the create defaults in the config.
 type IssueCreateCmd struct {
-	Project     string   `help:"Project key" short:"p"`
-	Type        string   `help:"Issue type" short:"t" default:"Task"`
-	Summary     string   `help:"Issue summary" short:"s" required:""`
-	Description string   `help:"Issue description" short:"d"`
-	Priority    string   `help:"Issue priority"`
-	Labels      []string `help:"Issue labels" short:"l"`
-	Parent      string   `help:"Parent issue key (creates a Subtask)" short:"P"`
+	Project         string   `help:"Project key" short:"p"`
+	Type            string   `help:"Issue type (default: config create.type, then Task)" short:"t"`
+	Summary         string   `help:"Issue summary" short:"s" required:""`
+	Description     string   `help:"Issue description (- reads stdin)" short:"d" xor:"description"`
+	DescriptionFile string   `help:"Path to a markdown description file" name:"description-file" type:"path" xor:"description"`
+	ADFFile         string   `help:"Path to a raw ADF JSON description (bypasses markdown conversion)" name:"adf-file" type:"path" xor:"description"`
+	Template        string   `help:"Description template name, read from .jira4claude/templates/NAME.md" short:"T" xor:"description"`
+	Editor          bool     `help:"Edit the description in $VISUAL or $EDITOR before creating"`
+	Priority        string   `help:"Issue priority"`
+	Labels          []string `help:"Issue labels (added to config defaults)" short:"l"`
+	Components      []string `help:"Component names (added to config defaults)" name:"component" short:"c"`
+	AffectsVersions []string `help:"Affected version names" name:"affects-version"`
+	FixVersions     []string `help:"Fix version names" name:"fix-version"`
+	Assignee        string   `help:"Assignee: me, an email, a display name or an account ID" short:"a"`
+	Watchers        []string `help:"Watchers to add: me, an email, a display name or an account ID (added to config defaults)" name:"watcher" short:"w"`
+	Parent          string   `help:"Parent issue key (creates a Subtask)" short:"P"`
+	NoDefaults      bool     `help:"Ignore create defaults and templates from config" name:"no-defaults"`
 }
 
 // Run executes the create command.
@@ -132,34 +173,106 @@ func (c *IssueCreateCmd) Run(ctx *IssueContext) error {
 		project = ctx.Config.Project
 	}
 
-	issueType := c.Type
+	defaults := ctx.Config.Create
+	if c.NoDefaults {
+		defaults = jira4claude.CreateDefaults{}
+	}
+
+	issueType := cmp.Or(c.Type, defaults.Type, "Task")
 	if c.Parent != "" {
 		issueType = "Sub-task"
 	}
 
+	if c.Editor && c.ADFFile != "" {
+		return &jira4claude.Error{Code: jira4claude.EValidation, Message: "--editor cannot be used with --adf-file"}
+	}
+
 	// Convert description to ADF (plain text is valid GFM)
 	var description jira4claude.ADF
-	if c.Description != "" {
+	var body string
+	data := &jira4claude.TemplateData{
+		Summary: c.Summary,
+		Type:    issueType,
+		Project: project,
+		Parent:  c.Parent,
+	}
+	switch {
+	case c.ADFFile != "":
+		var err error
+		description, err = readADFFile(c.ADFFile)
+		if err != nil {
+			return err
+		}
+	case c.Template != "":
+		var err error
+		body, err = ctx.Templates.Render(c.Template, withGitHead(ctx, data))
+		if err != nil {
+			return err
+		}
+	default:
+		text, err := readText(ctx.Stdin, c.Description, c.DescriptionFile)
+		if err != nil {
+			return err
+		}
+		body = text
+		if name, tmpl := templateFor(defaults.Templates, issueType); body == "" && tmpl != "" {
+			body, err = ctx.Templates.RenderText(keyCreateTemplates+"."+name, tmpl, withGitHead(ctx, data))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if c.Editor {
+		var err error
+		if body, err = editText(ctx.Editor, body); err != nil {
+			return err
+		}
+	}
+	if body != "" {
 		var warnings []string
-		description, warnings = ctx.Converter.ToADF(c.Description)
+		description, warnings = ctx.Converter.ToADF(body)
 		for _, w := range warnings {
 			ctx.Printer.Warning(w)
 		}
 	}
 
+	if description != nil {
+		if err := validateADF(ctx, description); err != nil {
+			return err
+		}
+	}
+
+	var assignee *jira4claude.User
+	if query := cmp.Or(c.Assignee, defaults.Assignee); query != "" {
+		user, err := resolveUser(context.Background(), ctx.Users, "", query)
+		if err != nil {
+			return err
+		}
+		assignee = &jira4claude.User{AccountID: user.AccountID}
+	}
+
+	watchers, err := resolveWatchers(ctx, mergeUnique(defaults.Watchers, c.Watchers))
+	if err != nil {
+		return err
+	}
+
 	var parent *jira4claude.LinkedIssue
 	if c.Parent != "" {
 		parent = &jira4claude.LinkedIssue{Key: c.Parent}
 	}
 
 	issue := &jira4claude.Issue{
-		Project:     project,
-		Type:        issueType,
-		Summary:     c.Summary,
-		Description: description,
-		Priority:    c.Priority,
-		Labels:      c.Labels,
-		Parent:      parent,
+		Project:         project,
+		Type:            issueType,
+		Summary:         c.Summary,
+		Description:     description,
+		Priority:        cmp.Or(c.Priority, defaults.Priority),
+		Labels:          mergeUnique(defaults.Labels, c.Labels),
+		Components:      mergeUnique(defaults.Components, c.Components),
+		AffectsVersions: c.AffectsVersions,
+		FixVersions:     c.FixVersions,
+		Assignee:        assignee,
+		Parent:          parent,
 	}
 
 	created, err := ctx.Service.Create(context.Background(), issue)
@@ -167,40 +280,427 @@ func (c *IssueCreateCmd) Run(ctx *IssueContext) error {
 		return err
 	}
 
+	addWatchers(ctx, created.Key, watchers)
+
 	ctx.Printer.Success("Created:", created.Key)
 	return nil
 }
 
+// resolveWatchers finds the users that watcher queries refer to. Commands
+// call it before creating anything so a bad query leaves nothing behind.
+func resolveWatchers(ctx *IssueContext, queries []string) ([]*jira4claude.User, error) {
+	var watchers []*jira4claude.User
+	for _, query := range queries {
+		user, err := resolveUser(context.Background(), ctx.Users, "", query)
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, user)
+	}
+	return watchers, nil
+}
+
+// addWatchers adds watchers to a newly created issue. The issue exists at
+// this point, so failing to add a watcher is only worth a warning.
+func addWatchers(ctx *IssueContext, key string, watchers []*jira4claude.User) {
+	for _, user := range watchers {
+		if err := ctx.Service.AddWatcher(context.Background(), key, user.AccountID); err != nil {
+			ctx.Printer.Warning("failed to add watcher " + userLabel(user) + " to " + key + ": " + err.Error())
+		}
+	}
+}
+
+// cloneLinkType names the link type connecting a copy to its original.
+const cloneLinkType = "Cloners"
+
+// IssueCloneCmd copies an issue.
+type IssueCloneCmd struct {
+	Key      string `arg:"" help:"Issue key to clone"`
+	Summary  string `help:"Summary of the copy (default: the original summary with --prefix)" short:"s"`
+	Prefix   string `help:"Prefix added to the original summary" default:"CLONE - "`
+	Project  string `help:"Project for the copy (default: the original's project)" short:"p"`
+	Subtasks bool   `help:"Also copy the subtasks or epic children under the copy"`
+	Links    bool   `help:"Recreate the original's issue links on the copy"`
+}
+
+// Run executes the clone command. The copy keeps the original's type,
+// description, labels, priority, parent, and within the same project its
+// components and fix versions, and is linked to the original with a Cloners
+// link. Every copy gets the create.watchers from config. Once the copy
+// exists, failures to copy children or links are reported as warnings so the
+// copy's key is not lost.
+func (c *IssueCloneCmd) Run(ctx *IssueContext) error {
+	original, err := ctx.Service.Get(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	watchers, err := resolveWatchers(ctx, ctx.Config.Create.Watchers)
+	if err != nil {
+		return err
+	}
+	types, err := ctx.Service.LinkTypes(context.Background())
+	if err != nil {
+		return err
+	}
+	cloners, _, err := jira4claude.ResolveLinkType(types, cloneLinkType)
+	if err != nil {
+		ctx.Printer.Warning("no " + cloneLinkType + " link type; copies will not be linked to their originals")
+	}
+
+	project := cmp.Or(c.Project, original.Project)
+	var parent *jira4claude.LinkedIssue
+	if original.Parent != nil {
+		parent = &jira4claude.LinkedIssue{Key: original.Parent.Key}
+	}
+	clone, err := ctx.Service.Create(context.Background(), cloneOf(original, project, cmp.Or(c.Summary, c.Prefix+original.Summary), parent))
+	if err != nil {
+		return err
+	}
+	linkClone(ctx, cloners, clone.Key, original.Key)
+	addWatchers(ctx, clone.Key, watchers)
+
+	if c.Links {
+		for _, link := range original.Links {
+			if cloners != nil && link.Type.Name == cloners.Name {
+				continue
+			}
+			from, to := clone.Key, ""
+			if link.OutwardIssue != nil {
+				to = link.OutwardIssue.Key
+			} else {
+				from, to = link.InwardIssue.Key, clone.Key
+			}
+			if err := ctx.Service.Link(context.Background(), from, link.Type.Name, to); err != nil {
+				ctx.Printer.Warning("failed to link " + from + " " + link.Type.Outward + " " + to + ": " + err.Error())
+			}
+		}
+	}
+
+	keys := []string{clone.Key}
+	if c.Subtasks {
+		keys = append(keys, c.cloneChildren(ctx, cloners, project, clone.Key, watchers)...)
+	}
+
+	ctx.Printer.Success("Cloned "+c.Key+" as", keys...)
+	return nil
+}
+
+// cloneChildren copies the issues whose parent is the original, subtasks or
+// epic children alike, under the copy with the given key, and returns the
+// keys of the copies. Failures are reported as warnings.
+func (c *IssueCloneCmd) cloneChildren(ctx *IssueContext, cloners *jira4claude.IssueLinkType, project, cloneKey string, watchers []*jira4claude.User) []string {
+	children, err := ctx.Service.List(context.Background(), jira4claude.IssueFilter{Parent: c.Key})
+	if err != nil {
+		ctx.Printer.Warning("failed to list the children of " + c.Key + ": " + err.Error())
+		return nil
+	}
+
+	var keys []string
+	for _, child := range children {
+		full, err := ctx.Service.Get(context.Background(), child.Key)
+		if err != nil {
+			ctx.Printer.Warning("failed to copy " + child.Key + ": " + err.Error())
+			continue
+		}
+		copied, err := ctx.Service.Create(context.Background(), cloneOf(full, project, full.Summary, &jira4claude.LinkedIssue{Key: cloneKey}))
+		if err != nil {
+			ctx.Printer.Warning("failed to copy " + child.Key + ": " + err.Error())
+			continue
+		}
+		linkClone(ctx, cloners, copied.Key, child.Key)
+		addWatchers(ctx, copied.Key, watchers)
+		keys = append(keys, copied.Key)
+	}
+	return keys
+}
+
+// cloneOf returns a new issue copying the fields of issue that clone keeps.
+// Components and versions belong to a project, so they are only copied
+// within it.
+func cloneOf(issue *jira4claude.Issue, project, summary string, parent *jira4claude.LinkedIssue) *jira4claude.Issue {
+	clone := &jira4claude.Issue{
+		Project:     project,
+		Type:        issue.Type,
+		Summary:     summary,
+		Description: issue.Description,
+		Priority:    issue.Priority,
+		Labels:      slices.Clone(issue.Labels),
+		Parent:      parent,
+	}
+	if project == issue.Project {
+		clone.Components = slices.Clone(issue.Components)
+		clone.FixVersions = slices.Clone(issue.FixVersions)
+	}
+	return clone
+}
+
+// linkClone links a copy to its original, warning when that fails. A nil
+// link type means the site has none for clones.
+func linkClone(ctx *IssueContext, cloners *jira4claude.IssueLinkType, copyKey, originalKey string) {
+	if cloners == nil {
+		return
+	}
+	if err := ctx.Service.Link(context.Background(), copyKey, cloners.Name, originalKey); err != nil {
+		ctx.Printer.Warning("failed to link " + copyKey + " to " + originalKey + ": " + err.Error())
+	}
+}
+
+// IssueSplitCmd breaks an issue into subtasks taken from its description.
+type IssueSplitCmd struct {
+	Key           string `arg:"" help:"Issue key to split"`
+	By            string `help:"What becomes a subtask: list items, ## sections, or auto (sections if the description has any, otherwise items)" enum:"auto,items,sections" default:"auto"`
+	DryRun        bool   `help:"Print the subtasks that would be created without creating them" name:"dry-run"`
+	BlockingChain bool   `help:"Link the subtasks in order so each one blocks the next" name:"blocking-chain"`
+}
+
+// Run executes the split command. Each top-level list item (completed task
+// items excepted) or ## section becomes a subtask of the issue, with the
+// item's nested content or the section's body as its description, and gets
+// the create.watchers from config. Parts with no text for a summary are
+// skipped with a warning. If a create fails partway, the subtasks already
+// created are still chained and watched before the error, which lists their
+// keys, is returned.
+func (c *IssueSplitCmd) Run(ctx *IssueContext) error {
+	issue, err := ctx.Service.Get(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+
+	var drafts []*jira4claude.Issue
+	for i, part := range c.parts(issue.Description) {
+		if part.Title == "" {
+			ctx.Printer.Warning(fmt.Sprintf("skipped part %d: it has no text to use as a summary", i+1))
+			continue
+		}
+		summary, truncated := truncateSummary(part.Title)
+		if truncated {
+			ctx.Printer.Warning(fmt.Sprintf("subtask %d: summary shortened to %d characters", len(drafts)+1, maxSummaryLength))
+		}
+		drafts = append(drafts, &jira4claude.Issue{
+			Project:     issue.Project,
+			Type:        "Sub-task",
+			Summary:     summary,
+			Description: part.Body,
+			Parent:      &jira4claude.LinkedIssue{Key: issue.Key},
+		})
+	}
+	if len(drafts) == 0 {
+		return &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "no checklist items or ## sections found in the description of " + c.Key,
+		}
+	}
+
+	if c.DryRun {
+		views := make([]jira4claude.IssueView, len(drafts))
+		for i, draft := range drafts {
+			views[i] = jira4claude.ToIssueView(draft, ctx.Converter, ctx.Printer.Warning, "")
+		}
+		ctx.Printer.Drafts(views)
+		return nil
+	}
+
+	// Resolve the link type and watchers up front so a missing one fails
+	// before anything is created.
+	watchers, err := resolveWatchers(ctx, ctx.Config.Create.Watchers)
+	if err != nil {
+		return err
+	}
+	var blocks *jira4claude.IssueLinkType
+	if c.BlockingChain {
+		types, err := ctx.Service.LinkTypes(context.Background())
+		if err != nil {
+			return err
+		}
+		if blocks, _, err = jira4claude.ResolveLinkType(types, "Blocks"); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(drafts))
+	var createErr error
+	for _, draft := range drafts {
+		created, err := ctx.Service.Create(context.Background(), draft)
+		if err != nil {
+			createErr = err
+			break
+		}
+		keys = append(keys, created.Key)
+		addWatchers(ctx, created.Key, watchers)
+	}
+	if len(keys) == 0 {
+		return createErr
+	}
+
+	if blocks != nil {
+		for i := 1; i < len(keys); i++ {
+			if err := ctx.Service.Link(context.Background(), keys[i-1], blocks.Name, keys[i]); err != nil {
+				ctx.Printer.Warning("failed to link " + keys[i-1] + " " + blocks.Outward + " " + keys[i] + ": " + err.Error())
+			}
+		}
+	}
+
+	if createErr != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.ErrorCode(createErr),
+			Message: fmt.Sprintf("stopped after creating %d of %d subtasks (%s)", len(keys), len(drafts), strings.Join(keys, ", ")),
+			Inner:   createErr,
+		}
+	}
+
+	ctx.Printer.Success("Split "+c.Key+" into", keys...)
+	return nil
+}
+
+// parts returns the pieces of description that become subtasks.
+func (c *IssueSplitCmd) parts(description jira4claude.ADF) []adf.Part {
+	switch c.By {
+	case "items":
+		return adf.Items(description)
+	case "sections":
+		return adf.Sections(description, 2)
+	}
+	if sections := adf.Sections(description, 2); len(sections) > 0 {
+		return sections
+	}
+	return adf.Items(description)
+}
+
+// keyCreateTemplates is the config key holding description templates by
+// issue type, used to name them in template errors.
+const keyCreateTemplates = "create.templates"
+
+// withGitHead fills the branch and commit placeholders of data from the local
+// git checkout when available, and returns data.
+func withGitHead(ctx *IssueContext, data *jira4claude.TemplateData) *jira4claude.TemplateData {
+	if head, err := ctx.Git.Head(context.Background()); err == nil {
+		data.Branch = head.Branch
+		data.Commit = head.Commit
+	}
+	return data
+}
+
+// templateFor returns the configured description template for issueType,
+// matching the type name case-insensitively, along with the name it is
+// configured under. Returns empty strings if there is none.
+func templateFor(templates map[string]string, issueType string) (name, tmpl string) {
+	for name, tmpl := range templates {
+		if strings.EqualFold(name, issueType) {
+			return name, tmpl
+		}
+	}
+	return "", ""
+}
+
+// mergeUnique returns the items of a followed by the items of b that are not
+// already present. Returns nil when both are empty.
+func mergeUnique(a, b []string) []string {
+	var result []string
+	for _, item := range slices.Concat(a, b) {
+		if !slices.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // IssueUpdateCmd updates an issue.
 type IssueUpdateCmd struct {
-	Key         string   `arg:"" help:"Issue key"`
-	Summary     *string  `help:"New summary" short:"s"`
-	Description *string  `help:"New description" short:"d"`
-	Priority    *string  `help:"New priority"`
-	Assignee    *string  `help:"New assignee" short:"a"`
-	Labels      []string `help:"New labels" short:"l"`
-	ClearLabels bool     `help:"Clear all labels" name:"clear-labels"`
-	Parent      *string  `help:"Parent issue key" short:"P" xor:"parent"`
-	ClearParent bool     `help:"Remove from parent" name:"clear-parent" xor:"parent"`
+	Key               string   `arg:"" help:"Issue key"`
+	Summary           *string  `help:"New summary" short:"s"`
+	Description       *string  `help:"New description (- reads stdin)" short:"d" xor:"description"`
+	DescriptionFile   string   `help:"Path to a markdown description file" name:"description-file" type:"path" xor:"description"`
+	ADFFile           string   `help:"Path to a raw ADF JSON description (bypasses markdown conversion)" name:"adf-file" type:"path" xor:"description"`
+	Editor            bool     `help:"Edit the description in $VISUAL or $EDITOR, starting from --description or the current description"`
+	Append            bool     `help:"Add the description after the current one" xor:"splice"`
+	Prepend           bool     `help:"Add the description before the current one" xor:"splice"`
+	ReplaceSection    string   `help:"Replace the section under this heading with the description" name:"replace-section" xor:"splice"`
+	Priority          *string  `help:"New priority"`
+	Assignee          *string  `help:"New assignee: me, an email, a display name or an account ID (empty to unassign)" short:"a"`
+	Labels            []string `help:"New labels, replacing all current labels" short:"l"`
+	ClearLabels       bool     `help:"Clear all labels" name:"clear-labels"`
+	AddLabels         []string `help:"Labels to add, keeping the others" name:"add-label"`
+	RemoveLabels      []string `help:"Labels to remove, keeping the others" name:"remove-label"`
+	Components        []string `help:"New components, replacing all current components"`
+	AddComponents     []string `help:"Components to add" name:"add-component"`
+	RemoveComponents  []string `help:"Components to remove" name:"remove-component"`
+	AffectsVersions   []string `help:"New affected versions, replacing all current ones" name:"affects-versions"`
+	FixVersions       []string `help:"New fix versions, replacing all current ones" name:"fix-versions"`
+	AddFixVersions    []string `help:"Fix versions to add" name:"add-fix-version"`
+	RemoveFixVersions []string `help:"Fix versions to remove" name:"remove-fix-version"`
+	Parent            *string  `help:"Parent issue key" short:"P" xor:"parent"`
+	ClearParent       bool     `help:"Remove from parent" name:"clear-parent" xor:"parent"`
 }
 
 // Run executes the update command.
 func (c *IssueUpdateCmd) Run(ctx *IssueContext) error {
+	if c.Editor && c.ADFFile != "" {
+		return &jira4claude.Error{Code: jira4claude.EValidation, Message: "--editor cannot be used with --adf-file"}
+	}
+
+	text, err := c.descriptionText(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Convert description to ADF (plain text is valid GFM)
 	var description *jira4claude.ADF
-	if c.Description != nil && *c.Description != "" {
-		adfDoc, warnings := ctx.Converter.ToADF(*c.Description)
+	if c.ADFFile != "" {
+		adfDoc, err := readADFFile(c.ADFFile)
+		if err != nil {
+			return err
+		}
+		description = &adfDoc
+	} else if text != "" {
+		adfDoc, warnings := ctx.Converter.ToADF(text)
 		for _, w := range warnings {
 			ctx.Printer.Warning(w)
 		}
 		description = &adfDoc
 	}
 
+	// Validate only the new content: when splicing, the rest of the
+	// description comes from Jira as is.
+	if description != nil {
+		if err := validateADF(ctx, *description); err != nil {
+			return err
+		}
+	}
+
+	if c.splicing() {
+		if description == nil {
+			return &jira4claude.Error{
+				Code:    jira4claude.EValidation,
+				Message: "--append, --prepend and --replace-section need a description (-d, --description-file, --adf-file or --editor)",
+			}
+		}
+		spliced, err := c.splice(ctx, *description)
+		if err != nil {
+			return err
+		}
+		description = &spliced
+	}
+
+	assignee := c.Assignee
+	if assignee != nil && *assignee != "" {
+		user, err := resolveUser(context.Background(), ctx.Users, c.Key, *assignee)
+		if err != nil {
+			return err
+		}
+		assignee = &user.AccountID
+	}
+
 	update := jira4claude.IssueUpdate{
-		Summary:     c.Summary,
-		Description: description,
-		Priority:    c.Priority,
-		Assignee:    c.Assignee,
+		Summary:           c.Summary,
+		Description:       description,
+		Priority:          c.Priority,
+		Assignee:          assignee,
+		AddLabels:         c.AddLabels,
+		RemoveLabels:      c.RemoveLabels,
+		AddComponents:     c.AddComponents,
+		RemoveComponents:  c.RemoveComponents,
+		AddFixVersions:    c.AddFixVersions,
+		RemoveFixVersions: c.RemoveFixVersions,
 	}
 
 	if len(c.Labels) > 0 {
@@ -209,6 +709,15 @@ func (c *IssueUpdateCmd) Run(ctx *IssueContext) error {
 		empty := []string{}
 		update.Labels = &empty
 	}
+	if len(c.Components) > 0 {
+		update.Components = &c.Components
+	}
+	if len(c.AffectsVersions) > 0 {
+		update.AffectsVersions = &c.AffectsVersions
+	}
+	if len(c.FixVersions) > 0 {
+		update.FixVersions = &c.FixVersions
+	}
 
 	if c.Parent != nil {
 		update.Parent = c.Parent
@@ -226,6 +735,82 @@ func (c *IssueUpdateCmd) Run(ctx *IssueContext) error {
 	return nil
 }
 
+// descriptionText returns the new markdown description from --description,
+// --description-file or the editor, or "" to leave the description unchanged.
+// The editor starts from the given description, or from the current one
+// converted to markdown; leaving the current one unchanged skips the update
+// so it is not round-tripped through markdown. When splicing, the editor
+// starts empty since its text is only the part to add.
+func (c *IssueUpdateCmd) descriptionText(ctx *IssueContext) (string, error) {
+	var text string
+	if c.Description != nil || c.DescriptionFile != "" {
+		var err error
+		text, err = readText(ctx.Stdin, ptrValue(c.Description), c.DescriptionFile)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !c.Editor {
+		return text, nil
+	}
+
+	current := false
+	if c.Description == nil && c.DescriptionFile == "" && !c.splicing() {
+		issue, err := ctx.Service.Get(context.Background(), c.Key)
+		if err != nil {
+			return "", err
+		}
+		if issue.Description != nil {
+			var warnings []string
+			text, warnings = ctx.Converter.ToMarkdown(issue.Description)
+			for _, w := range warnings {
+				ctx.Printer.Warning(w)
+			}
+		}
+		current = true
+	}
+
+	edited, err := editText(ctx.Editor, text)
+	if err != nil {
+		return "", err
+	}
+	if current && edited == text {
+		return "", nil
+	}
+	return edited, nil
+}
+
+// splicing reports whether the description is spliced into the current one
+// rather than replacing it.
+func (c *IssueUpdateCmd) splicing() bool {
+	return c.Append || c.Prepend || c.ReplaceSection != ""
+}
+
+// splice fetches the current description and splices part into it at the
+// ADF node level, so content the markdown converter cannot represent is kept.
+func (c *IssueUpdateCmd) splice(ctx *IssueContext, part jira4claude.ADF) (jira4claude.ADF, error) {
+	issue, err := ctx.Service.Get(context.Background(), c.Key)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c.Append:
+		return adf.Append(issue.Description, part), nil
+	case c.Prepend:
+		return adf.Prepend(issue.Description, part), nil
+	default:
+		return adf.ReplaceSection(issue.Description, c.ReplaceSection, part)
+	}
+}
+
+// ptrValue returns the value s points to, or "" if s is nil.
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // IssueTransitionsCmd lists available transitions.
 type IssueTransitionsCmd struct {
 	Key string `arg:"" help:"Issue key"`
@@ -246,6 +831,11 @@ type IssueTransitionCmd struct {
 	Key    string `arg:"" help:"Issue key"`
 	Status string `help:"Target status name" short:"s" xor:"target"`
 	ID     string `help:"Transition ID" short:"i" xor:"target"`
+	Path   bool   `help:"Reach --status along the shortest route of the workflow, printed before any step is taken"`
+
+	Resolution string            `help:"Resolution to set on the transition screen" short:"r"`
+	Fields     map[string]string `help:"Transition screen field as ID=VALUE; VALUE is sent as JSON when valid, otherwise as text (e.g., fixVersions='[{\"name\":\"1.2\"}]')" name:"field" mapsep:"none"`
+	Comment    string            `help:"Comment to add with the transition (- reads stdin)" short:"m"`
 }
 
 // Run executes the transition command.
@@ -256,6 +846,17 @@ func (c *IssueTransitionCmd) Run(ctx *IssueContext) error {
 			Message: "either --status or --id is required",
 		}
 	}
+	if c.Path && c.Status == "" {
+		return &jira4claude.Error{Code: jira4claude.EValidation, Message: "--path needs --status"}
+	}
+
+	input, err := c.input(ctx)
+	if err != nil {
+		return err
+	}
+	if c.Path {
+		return c.runPath(ctx, input)
+	}
 
 	transitions, err := ctx.Service.Transitions(context.Background(), c.Key)
 	if err != nil {
@@ -273,18 +874,14 @@ func (c *IssueTransitionCmd) Run(ctx *IssueContext) error {
 			}
 		}
 		if transitionID == "" {
-			available := make([]string, len(transitions))
-			for i, t := range transitions {
-				available[i] = `"` + t.Name + `"`
-			}
 			return &jira4claude.Error{
 				Code:    jira4claude.EValidation,
-				Message: `status "` + c.Status + `" not found; available: ` + strings.Join(available, ", "),
+				Message: `status "` + c.Status + `" not found; available: ` + quotedNames(transitions),
 			}
 		}
 	}
 
-	if err := ctx.Service.Transition(context.Background(), c.Key, transitionID); err != nil {
+	if err := ctx.Service.Transition(context.Background(), c.Key, transitionID, input); err != nil {
 		return err
 	}
 
@@ -292,38 +889,444 @@ func (c *IssueTransitionCmd) Run(ctx *IssueContext) error {
 	return nil
 }
 
+// input builds the transition screen values from the flags.
+func (c *IssueTransitionCmd) input(ctx *IssueContext) (jira4claude.TransitionInput, error) {
+	comment, err := commentADF(ctx, c.Comment)
+	if err != nil {
+		return jira4claude.TransitionInput{}, err
+	}
+	input := jira4claude.TransitionInput{Resolution: c.Resolution, Comment: comment}
+	if len(c.Fields) > 0 {
+		input.Fields = make(map[string]any, len(c.Fields))
+		for id, value := range c.Fields {
+			input.Fields[id] = fieldValue(value)
+		}
+	}
+	return input, nil
+}
+
+// fieldValue decodes a --field value as JSON, falling back to the raw text
+// so plain strings need no quoting.
+func fieldValue(value string) any {
+	var v any
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return value
+	}
+	return v
+}
+
+// runPath plans the shortest route to the target status through the issue's
+// workflow, prints it, and only then takes it step by step. Reading the
+// workflow needs Jira administrator permission; without it only a target one
+// transition away can be planned, and any other target is refused rather
+// than guessed at. --field and --resolution values are set on every step
+// whose screen has the field, and the comment is added with the final step.
+func (c *IssueTransitionCmd) runPath(ctx *IssueContext, input jira4claude.TransitionInput) error {
+	issue, err := ctx.Service.Get(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(issue.Status, c.Status) {
+		ctx.Printer.Success("Already "+issue.Status+":", c.Key)
+		return nil
+	}
+
+	plan, err := c.plan(ctx, issue.Status)
+	if err != nil {
+		return err
+	}
+	ctx.Printer.Transitions(c.Key, plan)
+
+	status := issue.Status
+	for i, step := range plan {
+		if err := c.takeStep(ctx, step, input, i == len(plan)-1); err != nil {
+			if i == 0 {
+				return err
+			}
+			return &jira4claude.Error{
+				Code:    jira4claude.ErrorCode(err),
+				Message: fmt.Sprintf("stopped after %s, issue left in %q: %s", quotedNames(plan[:i]), status, jira4claude.ErrorMessage(err)),
+				Inner:   err,
+			}
+		}
+		status = step.To
+	}
+
+	ctx.Printer.Success("Transitioned ("+status+"):", c.Key)
+	return nil
+}
+
+// plan returns the transitions leading from status to the target status.
+func (c *IssueTransitionCmd) plan(ctx *IssueContext, status string) ([]*jira4claude.Transition, error) {
+	workflow, err := ctx.Workflows.Workflow(context.Background(), c.Key)
+	if err != nil {
+		// The offered transitions only show one step ahead.
+		transitions, terr := ctx.Service.Transitions(context.Background(), c.Key)
+		if terr != nil {
+			return nil, terr
+		}
+		for _, t := range transitions {
+			if strings.EqualFold(t.To, c.Status) {
+				return []*jira4claude.Transition{t}, nil
+			}
+		}
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.ErrorCode(err),
+			Message: fmt.Sprintf("cannot plan a route from %q to %q without reading the workflow of %s (needs Jira administrator permission): %s", status, c.Status, c.Key, jira4claude.ErrorMessage(err)),
+			Inner:   err,
+		}
+	}
+
+	path, err := workflow.Path(status, c.Status)
+	if err != nil {
+		return nil, err
+	}
+	plan := make([]*jira4claude.Transition, len(path))
+	for i, t := range path {
+		plan[i] = &jira4claude.Transition{ID: t.ID, Name: t.Name, To: t.To}
+	}
+	return plan, nil
+}
+
+// takeStep takes one planned transition. The issue must still offer it; the
+// offered transition tells which fields its screen has.
+func (c *IssueTransitionCmd) takeStep(ctx *IssueContext, step *jira4claude.Transition, input jira4claude.TransitionInput, final bool) error {
+	transitions, err := ctx.Service.Transitions(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	i := slices.IndexFunc(transitions, func(t *jira4claude.Transition) bool { return t.ID == step.ID })
+	if i < 0 {
+		msg := "transition " + strconv.Quote(step.Name) + " is not offered"
+		if len(transitions) > 0 {
+			msg += "; available: " + quotedNames(transitions)
+		}
+		return &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+	}
+	return ctx.Service.Transition(context.Background(), c.Key, step.ID, stepInput(transitions[i], input, final))
+}
+
+// stepInput returns the screen values for one step of a path: everything on
+// the final step, and on earlier steps the values of fields their screen has.
+func stepInput(step *jira4claude.Transition, input jira4claude.TransitionInput, final bool) jira4claude.TransitionInput {
+	if final {
+		return input
+	}
+	var out jira4claude.TransitionInput
+	for _, f := range step.Fields {
+		if f.ID == "resolution" {
+			out.Resolution = input.Resolution
+		}
+		if value, ok := input.Fields[f.ID]; ok {
+			if out.Fields == nil {
+				out.Fields = map[string]any{}
+			}
+			out.Fields[f.ID] = value
+		}
+	}
+	return out
+}
+
+// IssueStartCmd picks up an issue: it assigns it to the authenticated user
+// and takes the first transition to an in-progress status.
+type IssueStartCmd struct {
+	Key     string `arg:"" help:"Issue key"`
+	Comment string `help:"Comment to add with the transition (- reads stdin)" short:"m"`
+}
+
+// Run executes the start command.
+func (c *IssueStartCmd) Run(ctx *IssueContext) error {
+	comment, err := commentADF(ctx, c.Comment)
+	if err != nil {
+		return err
+	}
+
+	me, err := ctx.Users.Me(context.Background())
+	if err != nil {
+		return err
+	}
+	transitions, err := ctx.Service.Transitions(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	transition, err := transitionTo(transitions, jira4claude.StatusCategoryInProgress, "")
+	if err != nil {
+		return err
+	}
+
+	// Transition first: a workflow validator or required field is the likelier
+	// failure, and it then leaves the issue as it was.
+	status := cmp.Or(transition.To, transition.Name)
+	if err := ctx.Service.Transition(context.Background(), c.Key, transition.ID, jira4claude.TransitionInput{Comment: comment}); err != nil {
+		return err
+	}
+	if err := ctx.Service.Assign(context.Background(), c.Key, me.AccountID); err != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.ErrorCode(err),
+			Message: "moved " + c.Key + " to " + status + " but could not assign it: " + jira4claude.ErrorMessage(err),
+			Inner:   err,
+		}
+	}
+
+	ctx.Printer.Success("Started ("+status+"):", c.Key)
+	return nil
+}
+
+// IssueDoneCmd finishes an issue by taking a transition to a done status.
+type IssueDoneCmd struct {
+	Key        string `arg:"" help:"Issue key"`
+	Status     string `help:"Done status to move to when the workflow has several (default: the first)" short:"s"`
+	Resolution string `help:"Resolution to set (e.g., Done, Won't Do)" short:"r"`
+	Comment    string `help:"Closing comment (- reads stdin)" short:"m"`
+}
+
+// Run executes the done command.
+func (c *IssueDoneCmd) Run(ctx *IssueContext) error {
+	comment, err := commentADF(ctx, c.Comment)
+	if err != nil {
+		return err
+	}
+
+	transitions, err := ctx.Service.Transitions(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	transition, err := transitionTo(transitions, jira4claude.StatusCategoryDone, c.Status)
+	if err != nil {
+		return err
+	}
+
+	input := jira4claude.TransitionInput{Resolution: c.Resolution, Comment: comment}
+	if err := ctx.Service.Transition(context.Background(), c.Key, transition.ID, input); err != nil {
+		return err
+	}
+
+	ctx.Printer.Success("Done ("+cmp.Or(transition.To, transition.Name)+"):", c.Key)
+	return nil
+}
+
+// transitionTo returns the first transition into a status of the given
+// category, or the one named status when set. The status is matched against
+// the transition name and its target status, case-insensitively.
+func transitionTo(transitions []*jira4claude.Transition, category, status string) (*jira4claude.Transition, error) {
+	var candidates []*jira4claude.Transition
+	for _, t := range transitions {
+		if t.Category != category {
+			continue
+		}
+		if status == "" || strings.EqualFold(t.Name, status) || strings.EqualFold(t.To, status) {
+			return t, nil
+		}
+		candidates = append(candidates, t)
+	}
+
+	if status != "" && len(candidates) > 0 {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: `status "` + status + `" not found; available: ` + quotedNames(candidates),
+		}
+	}
+	msg := "no transition to a status in the " + categoryLabel(category) + " category"
+	if len(transitions) > 0 {
+		msg += "; available: " + quotedNames(transitions)
+	}
+	return nil, &jira4claude.Error{Code: jira4claude.EValidation, Message: msg}
+}
+
+// categoryLabel returns the display name of a status category key.
+func categoryLabel(category string) string {
+	switch category {
+	case jira4claude.StatusCategoryToDo:
+		return "To Do"
+	case jira4claude.StatusCategoryInProgress:
+		return "In Progress"
+	case jira4claude.StatusCategoryDone:
+		return "Done"
+	}
+	return category
+}
+
+// quotedNames returns the quoted transition names joined by commas.
+func quotedNames(transitions []*jira4claude.Transition) string {
+	names := make([]string, len(transitions))
+	for i, t := range transitions {
+		names[i] = `"` + t.Name + `"`
+	}
+	return strings.Join(names, ", ")
+}
+
+// commentADF converts a markdown comment to ADF, reading it from stdin when
+// text is "-". Returns nil when there is no comment.
+func commentADF(ctx *IssueContext, text string) (jira4claude.ADF, error) {
+	text, err := readText(ctx.Stdin, text, "")
+	if err != nil || text == "" {
+		return nil, err
+	}
+	body, warnings := ctx.Converter.ToADF(text)
+	for _, w := range warnings {
+		ctx.Printer.Warning(w)
+	}
+	if err := validateADF(ctx, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// validateADF checks doc against the ADF schema before it is sent to Jira,
+// printing warnings about node types the schema does not know.
+func validateADF(ctx *IssueContext, doc jira4claude.ADF) error {
+	warnings, err := adf.Validate(doc)
+	for _, w := range warnings {
+		ctx.Printer.Warning(w)
+	}
+	return err
+}
+
 // IssueAssignCmd assigns an issue.
 type IssueAssignCmd struct {
-	Key       string `arg:"" help:"Issue key"`
-	AccountID string `help:"User account ID (omit to unassign)" short:"a"`
+	Key      string `arg:"" help:"Issue key"`
+	Assignee string `help:"User to assign: me, an email, a display name or an account ID (omit to unassign)" short:"a" aliases:"account-id"`
 }
 
 // Run executes the assign command.
 func (c *IssueAssignCmd) Run(ctx *IssueContext) error {
-	if err := ctx.Service.Assign(context.Background(), c.Key, c.AccountID); err != nil {
+	if c.Assignee == "" {
+		if err := ctx.Service.Assign(context.Background(), c.Key, ""); err != nil {
+			return err
+		}
+		ctx.Printer.Success("Unassigned:", c.Key)
+		return nil
+	}
+
+	user, err := resolveUser(context.Background(), ctx.Users, c.Key, c.Assignee)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Service.Assign(context.Background(), c.Key, user.AccountID); err != nil {
 		return err
 	}
 
-	if c.AccountID == "" {
-		ctx.Printer.Success("Unassigned:", c.Key)
+	if user.DisplayName != "" {
+		ctx.Printer.Success("Assigned to "+user.DisplayName+":", c.Key)
 	} else {
 		ctx.Printer.Success("Assigned:", c.Key)
 	}
 	return nil
 }
 
+// IssueWatchCmd adds a watcher to an issue.
+type IssueWatchCmd struct {
+	Key  string `arg:"" help:"Issue key"`
+	User string `help:"User to add: me, an email, a display name or an account ID" short:"u" default:"me"`
+}
+
+// Run executes the watch command.
+func (c *IssueWatchCmd) Run(ctx *IssueContext) error {
+	user, err := resolveUser(context.Background(), ctx.Users, "", c.User)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Service.AddWatcher(context.Background(), c.Key, user.AccountID); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Added watcher "+userLabel(user)+" to", c.Key)
+	return nil
+}
+
+// IssueUnwatchCmd removes a watcher from an issue.
+type IssueUnwatchCmd struct {
+	Key  string `arg:"" help:"Issue key"`
+	User string `help:"User to remove: me, an email, a display name or an account ID" short:"u" default:"me"`
+}
+
+// Run executes the unwatch command.
+func (c *IssueUnwatchCmd) Run(ctx *IssueContext) error {
+	user, err := resolveUser(context.Background(), ctx.Users, "", c.User)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Service.RemoveWatcher(context.Background(), c.Key, user.AccountID); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Removed watcher "+userLabel(user)+" from", c.Key)
+	return nil
+}
+
+// IssueWatchersCmd lists the watchers of an issue.
+type IssueWatchersCmd struct {
+	Key string `arg:"" help:"Issue key"`
+}
+
+// Run executes the watchers command.
+func (c *IssueWatchersCmd) Run(ctx *IssueContext) error {
+	users, err := ctx.Service.Watchers(context.Background(), c.Key)
+	if err != nil {
+		return err
+	}
+	ctx.Printer.Users(users)
+	return nil
+}
+
+// IssueVoteCmd votes for an issue as the authenticated user.
+type IssueVoteCmd struct {
+	Key string `arg:"" help:"Issue key"`
+}
+
+// Run executes the vote command.
+func (c *IssueVoteCmd) Run(ctx *IssueContext) error {
+	if err := ctx.Service.Vote(context.Background(), c.Key); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Voted for", c.Key)
+	return nil
+}
+
+// IssueUnvoteCmd removes the authenticated user's vote from an issue.
+type IssueUnvoteCmd struct {
+	Key string `arg:"" help:"Issue key"`
+}
+
+// Run executes the unvote command.
+func (c *IssueUnvoteCmd) Run(ctx *IssueContext) error {
+	if err := ctx.Service.Unvote(context.Background(), c.Key); err != nil {
+		return err
+	}
+	ctx.Printer.Success("Removed vote from", c.Key)
+	return nil
+}
+
 // IssueCommentCmd adds a comment.
 type IssueCommentCmd struct {
-	Key  string `arg:"" help:"Issue key"`
-	Body string `help:"Comment body" short:"b" required:""`
+	Key      string `arg:"" help:"Issue key"`
+	Body     string `help:"Comment body (- reads stdin)" short:"b" required:"" xor:"body"`
+	BodyFile string `help:"Path to a markdown comment body file" name:"body-file" type:"path" required:"" xor:"body"`
+	BodyADF  string `help:"Path to a raw ADF JSON comment body (bypasses markdown conversion)" name:"body-adf" type:"path" required:"" xor:"body"`
 }
 
 // Run executes the comment command.
 func (c *IssueCommentCmd) Run(ctx *IssueContext) error {
-	// Convert body to ADF (plain text is valid GFM)
-	body, warnings := ctx.Converter.ToADF(c.Body)
-	for _, w := range warnings {
-		ctx.Printer.Warning(w)
+	var body jira4claude.ADF
+	if c.BodyADF != "" {
+		var err error
+		body, err = readADFFile(c.BodyADF)
+		if err != nil {
+			return err
+		}
+	} else {
+		text, err := readText(ctx.Stdin, c.Body, c.BodyFile)
+		if err != nil {
+			return err
+		}
+		// Convert body to ADF (plain text is valid GFM)
+		var warnings []string
+		body, warnings = ctx.Converter.ToADF(text)
+		for _, w := range warnings {
+			ctx.Printer.Warning(w)
+		}
+	}
+
+	if err := validateADF(ctx, body); err != nil {
+		return err
 	}
 
 	comment, err := ctx.Service.AddComment(context.Background(), c.Key, body)
@@ -2,8 +2,14 @@ package main_test
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/fwojciec/jira4claude"
 	main "github.com/fwojciec/jira4claude/cmd/j4c"
@@ -165,6 +171,509 @@ func TestIssueTransitionCmd_InvalidStatusShowsQuotedOptions(t *testing.T) {
 	assert.Contains(t, errMsg, `"Done"`)
 }
 
+func TestIssueTransitionCmd_ScreenValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sends resolution, fields and comment", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.TransitionInput
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return []*jira4claude.Transition{{ID: "31", Name: "Done"}}, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					captured = input
+					return nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueTransitionCmd{
+			Key:        "TEST-1",
+			Status:     "Done",
+			Resolution: "Fixed",
+			Fields: map[string]string{
+				"fixVersions":       `[{"name": "1.2"}]`,
+				"customfield_10010": "plain text",
+			},
+			Comment: "Shipped in 1.2",
+		}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Fixed", captured.Resolution)
+		assert.Equal(t, map[string]any{
+			"fixVersions":       []any{map[string]any{"name": "1.2"}},
+			"customfield_10010": "plain text",
+		}, captured.Fields)
+		assert.NotNil(t, captured.Comment)
+	})
+
+	t.Run("sends no screen values by default", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.TransitionInput
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return nil, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					captured = input
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", ID: "31"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, jira4claude.TransitionInput{}, captured)
+	})
+}
+
+func TestIssueTransitionCmd_Path(t *testing.T) {
+	t.Parallel()
+
+	// The Block detour is listed first so a route that is not the shortest
+	// would wander into it.
+	workflow := &jira4claude.Workflow{
+		Name: "Review workflow",
+		Transitions: []*jira4claude.WorkflowTransition{
+			{ID: "12", Name: "Block", From: []string{"To Do"}, To: "Blocked"},
+			{ID: "11", Name: "Start", From: []string{"To Do"}, To: "In Progress"},
+			{ID: "13", Name: "Unblock", From: []string{"Blocked"}, To: "To Do"},
+			{ID: "21", Name: "Review", From: []string{"In Progress"}, To: "In Review"},
+			{ID: "31", Name: "Approve", From: []string{"In Review"}, To: "Done"},
+		},
+	}
+	reviewer := &jira4claude.TransitionField{ID: "customfield_10010", Name: "Reviewer"}
+
+	// newCtx simulates an issue in To Do that moves along workflow as
+	// transitions are taken. Transitions with IDs in blocked are never
+	// offered, and readable says whether the workflow can be read.
+	newCtx := func(workflow *jira4claude.Workflow, readable bool, taken *[]string, blocked ...string) (*main.IssueContext, *mock.Printer) {
+		status := "To Do"
+		printer := &mock.Printer{}
+		return &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Status: status}, nil
+				},
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					var available []*jira4claude.Transition
+					for _, t := range workflow.Transitions {
+						if t.From[0] != status || slices.Contains(blocked, t.ID) {
+							continue
+						}
+						transition := &jira4claude.Transition{ID: t.ID, Name: t.Name, To: t.To, Category: jira4claude.StatusCategoryInProgress}
+						if t.To == "Done" {
+							transition.Category = jira4claude.StatusCategoryDone
+						}
+						if t.ID == "21" {
+							transition.Fields = []*jira4claude.TransitionField{reviewer}
+						}
+						available = append(available, transition)
+					}
+					return available, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					*taken = append(*taken, transitionID)
+					for _, t := range workflow.Transitions {
+						if t.ID == transitionID {
+							status = t.To
+						}
+					}
+					return nil
+				},
+			},
+			Workflows: &mock.WorkflowService{
+				WorkflowFn: func(ctx context.Context, key string) (*jira4claude.Workflow, error) {
+					if !readable {
+						return nil, &jira4claude.Error{Code: jira4claude.EForbidden, Message: "You are not authorized to perform this action."}
+					}
+					return workflow, nil
+				},
+			},
+			Printer:   printer,
+			Converter: mockConverter(),
+		}, printer
+	}
+
+	t.Run("follows the workflow and prints the steps taken", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, true, &taken)
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "done", Path: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"11", "21", "31"}, taken)
+		require.Len(t, printer.TransitionsCalls, 1)
+		var planned []string
+		for _, step := range printer.TransitionsCalls[0].Transitions {
+			planned = append(planned, step.Name)
+		}
+		assert.Equal(t, []string{"Start", "Review", "Approve"}, planned)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Transitioned (Done):", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("prints the plan before taking any step", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, true, &taken)
+		svc := ctx.Service.(*mock.IssueService)
+		transition := svc.TransitionFn
+		svc.TransitionFn = func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+			assert.Len(t, printer.TransitionsCalls, 1)
+			return transition(ctx, key, transitionID, input)
+		}
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "Done", Path: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Len(t, taken, 3)
+	})
+
+	t.Run("takes an offered step when the workflow cannot be read", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, false, &taken)
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "In Progress", Path: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"11"}, taken)
+		require.Len(t, printer.SuccessCalls, 1)
+	})
+
+	t.Run("refuses a longer route when the workflow cannot be read", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, false, &taken)
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "Done", Path: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EForbidden, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `cannot plan a route from "To Do" to "Done" without reading the workflow of TEST-1`)
+		assert.Empty(t, taken)
+		assert.Empty(t, printer.TransitionsCalls)
+	})
+
+	t.Run("sets screen values on every step whose screen has them", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, _ := newCtx(workflow, true, &taken)
+		svc := ctx.Service.(*mock.IssueService)
+		transition := svc.TransitionFn
+		var inputs []jira4claude.TransitionInput
+		svc.TransitionFn = func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+			inputs = append(inputs, input)
+			return transition(ctx, key, transitionID, input)
+		}
+		cmd := main.IssueTransitionCmd{
+			Key:        "TEST-1",
+			Status:     "Done",
+			Path:       true,
+			Resolution: "Fixed",
+			Fields:     map[string]string{"customfield_10010": "jane", "customfield_10020": "3"},
+			Comment:    "Shipped",
+		}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, inputs, 3)
+		assert.Equal(t, jira4claude.TransitionInput{}, inputs[0])
+		assert.Equal(t, jira4claude.TransitionInput{Fields: map[string]any{"customfield_10010": "jane"}}, inputs[1])
+		assert.Equal(t, "Fixed", inputs[2].Resolution)
+		assert.Equal(t, map[string]any{"customfield_10010": "jane", "customfield_10020": float64(3)}, inputs[2].Fields)
+		assert.NotNil(t, inputs[2].Comment)
+	})
+
+	t.Run("stops when a planned transition is not offered", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, true, &taken, "21")
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "Done", Path: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `stopped after "Start", issue left in "In Progress": transition "Review" is not offered`)
+		assert.Equal(t, []string{"11"}, taken)
+		require.Len(t, printer.TransitionsCalls, 1)
+		assert.Empty(t, printer.SuccessCalls)
+	})
+
+	t.Run("leaves the issue alone when the workflow has no route", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, true, &taken)
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "Archived", Path: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Empty(t, taken)
+		assert.Empty(t, printer.TransitionsCalls)
+	})
+
+	t.Run("does nothing when already in the target status", func(t *testing.T) {
+		t.Parallel()
+
+		var taken []string
+		ctx, printer := newCtx(workflow, true, &taken)
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", Status: "To Do", Path: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Empty(t, taken)
+		assert.Empty(t, printer.TransitionsCalls)
+	})
+
+	t.Run("requires a target status", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := main.IssueTransitionCmd{Key: "TEST-1", ID: "11", Path: true}
+
+		err := cmd.Run(&main.IssueContext{})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+// IssueStartCmd and IssueDoneCmd tests
+
+func TestIssueStartCmd(t *testing.T) {
+	t.Parallel()
+
+	// Transition names differ from their target statuses.
+	transitions := []*jira4claude.Transition{
+		{ID: "11", Name: "Backlog", To: "To Do", Category: jira4claude.StatusCategoryToDo},
+		{ID: "21", Name: "Start work", To: "Doing", Category: jira4claude.StatusCategoryInProgress},
+		{ID: "31", Name: "Reject", To: "Won't Do", Category: jira4claude.StatusCategoryDone},
+		{ID: "41", Name: "Finish", To: "Closed", Category: jira4claude.StatusCategoryDone},
+	}
+	me := &mock.UserService{
+		MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+			return &jira4claude.User{AccountID: "acc-me"}, nil
+		},
+	}
+
+	t.Run("assigns to me and takes the in-progress transition", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		var captured jira4claude.TransitionInput
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					calls = append(calls, "transition "+transitionID)
+					captured = input
+					return nil
+				},
+				AssignFn: func(ctx context.Context, key, accountID string) error {
+					calls = append(calls, "assign "+accountID)
+					return nil
+				},
+			},
+			Users:     me,
+			Printer:   printer,
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueStartCmd{Key: "TEST-1", Comment: "Picking this up"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"transition 21", "assign acc-me"}, calls)
+		assert.NotNil(t, captured.Comment)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Started (Doing):", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("does not assign when the transition fails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					return &jira4claude.Error{Code: jira4claude.EValidation, Message: "Story points is required"}
+				},
+			}, // panics on Assign
+			Users:     me,
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueStartCmd{Key: "TEST-1"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("reports the new status when assigning fails", func(t *testing.T) {
+		t.Parallel()
+
+		var transitioned []string
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					transitioned = append(transitioned, transitionID)
+					return nil
+				},
+				AssignFn: func(ctx context.Context, key, accountID string) error {
+					return &jira4claude.Error{Code: jira4claude.EForbidden, Message: "cannot assign"}
+				},
+			},
+			Users:     me,
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueStartCmd{Key: "TEST-1"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EForbidden, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "moved TEST-1 to Doing but could not assign it: cannot assign")
+		assert.Equal(t, []string{"21"}, transitioned)
+	})
+
+	t.Run("fails before changing anything without an in-progress transition", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions[2:], nil
+				},
+			}, // panics on Transition and Assign
+			Users:     me,
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueStartCmd{Key: "TEST-1"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "no transition to a status in the In Progress category")
+		assert.Contains(t, err.Error(), `"Reject", "Finish"`)
+	})
+}
+
+func TestIssueDoneCmd(t *testing.T) {
+	t.Parallel()
+
+	// Transition names differ from their target statuses.
+	transitions := []*jira4claude.Transition{
+		{ID: "11", Name: "Backlog", To: "To Do", Category: jira4claude.StatusCategoryToDo},
+		{ID: "21", Name: "Start work", To: "Doing", Category: jira4claude.StatusCategoryInProgress},
+		{ID: "31", Name: "Reject", To: "Won't Do", Category: jira4claude.StatusCategoryDone},
+		{ID: "41", Name: "Finish", To: "Closed", Category: jira4claude.StatusCategoryDone},
+	}
+
+	t.Run("takes the first done transition with resolution and comment", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedID string
+		var captured jira4claude.TransitionInput
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					capturedID, captured = transitionID, input
+					return nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueDoneCmd{Key: "TEST-1", Resolution: "Won't Do", Comment: "Duplicate"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "31", capturedID)
+		assert.Equal(t, "Won't Do", captured.Resolution)
+		assert.NotNil(t, captured.Comment)
+	})
+
+	t.Run("picks the done status by target name", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedID string
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+				TransitionFn: func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+					capturedID = transitionID
+					return nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueDoneCmd{Key: "TEST-1", Status: "closed"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "41", capturedID)
+	})
+
+	t.Run("lists done transitions for an unknown status", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				TransitionsFn: func(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
+					return transitions, nil
+				},
+			}, // panics on Transition
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueDoneCmd{Key: "TEST-1", Status: "Shipped"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `status "Shipped" not found; available: "Reject", "Finish"`)
+	})
+}
+
 // IssueCreateCmd tests
 
 func TestIssueCreateCmd(t *testing.T) {
@@ -230,66 +739,1409 @@ func TestIssueCreateCmd(t *testing.T) {
 		assert.Equal(t, "doc", capturedIssue.Description["type"])
 	})
 
-	t.Run("skips conversion when description is empty", func(t *testing.T) {
+	t.Run("skips conversion when description is empty", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		svc := &mock.IssueService{
+			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+				capturedIssue = issue
+				return &jira4claude.Issue{Key: "TEST-1"}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
+		}
+		cmd := main.IssueCreateCmd{
+			Summary:     "Test issue",
+			Description: "",
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, capturedIssue)
+		// Description should remain empty
+		assert.Empty(t, capturedIssue.Description)
+	})
+
+	t.Run("sets type to Sub-task when parent is specified", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		svc := &mock.IssueService{
+			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+				capturedIssue = issue
+				return &jira4claude.Issue{Key: "TEST-2"}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
+		}
+		cmd := main.IssueCreateCmd{
+			Summary: "Subtask issue",
+			Parent:  "TEST-1",
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, capturedIssue)
+		// Type must be exactly "Sub-task" (with hyphen) for Jira API
+		assert.Equal(t, "Sub-task", capturedIssue.Type)
+		require.NotNil(t, capturedIssue.Parent)
+		assert.Equal(t, "TEST-1", capturedIssue.Parent.Key)
+	})
+	t.Run("sends raw ADF from file without conversion", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "desc.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"doc","version":1,"content":[{"type":"rule"}]}`), 0o600))
+
+		var capturedIssue *jira4claude.Issue
+		svc := &mock.IssueService{
+			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+				capturedIssue = issue
+				return &jira4claude.Issue{Key: "TEST-1"}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: &mock.Converter{}, // panics if ToADF is called
+			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
+		}
+		cmd := main.IssueCreateCmd{
+			Summary: "Test issue",
+			ADFFile: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, capturedIssue)
+		assert.Equal(t, jira4claude.ADF{
+			"type":    "doc",
+			"version": float64(1),
+			"content": []any{map[string]any{"type": "rule"}},
+		}, capturedIssue.Description)
+	})
+
+	t.Run("sends ADF with captions and unknown nodes, warning about the unknown ones", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "desc.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"doc","version":1,"content":[
+			{"type":"mediaSingle","content":[{"type":"media","attrs":{"id":"abc","type":"file"}},{"type":"caption","content":[{"type":"text","text":"Screenshot"}]}]},
+			{"type":"syncBlock","attrs":{"resourceId":"r1"}}
+		]}`), 0o600))
+
+		var capturedIssue *jira4claude.Issue
+		svc := &mock.IssueService{
+			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+				capturedIssue = issue
+				return &jira4claude.Issue{Key: "TEST-1"}, nil
+			},
+		}
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: &mock.Converter{},
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{
+			Summary: "Test issue",
+			ADFFile: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, capturedIssue)
+		assert.Equal(t, []string{`content[1]: unknown node type "syncBlock" was not checked`}, printer.WarningCalls)
+	})
+
+	t.Run("returns validation error when ADF file is not a document", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "desc.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"paragraph"}`), 0o600))
+
+		ctx := &main.IssueContext{
+			Service:   &mock.IssueService{},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{},
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{
+			Summary: "Test issue",
+			ADFFile: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestIssueCloneCmd(t *testing.T) {
+	t.Parallel()
+
+	description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{}}
+	issues := map[string]*jira4claude.Issue{
+		"TEST-1": {
+			Key:         "TEST-1",
+			Project:     "TEST",
+			Type:        "Story",
+			Summary:     "Weekly dependency bump",
+			Description: description,
+			Priority:    "High",
+			Labels:      []string{"chore"},
+			Components:  []string{"API"},
+			FixVersions: []string{"1.2"},
+			Parent:      &jira4claude.LinkedIssue{Key: "TEST-100", Summary: "Maintenance"},
+			Links: []*jira4claude.IssueLink{
+				{ID: "1", Type: jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}, OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-7"}},
+				{ID: "2", Type: jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}, InwardIssue: &jira4claude.LinkedIssue{Key: "TEST-8"}},
+				{ID: "3", Type: jira4claude.IssueLinkType{Name: "Cloners", Outward: "clones", Inward: "is cloned by"}, OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-0"}},
+			},
+		},
+		"TEST-2": {Key: "TEST-2", Project: "TEST", Type: "Sub-task", Summary: "Bump Go modules", Labels: []string{"go"}},
+		"TEST-3": {Key: "TEST-3", Project: "TEST", Type: "Story", Summary: "Bump npm packages"},
+	}
+	getIssue := func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+		return issues[key], nil
+	}
+	clonerTypes := func(ctx context.Context) ([]*jira4claude.IssueLinkType, error) {
+		return []*jira4claude.IssueLinkType{
+			{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+			{Name: "Cloners", Outward: "clones", Inward: "is cloned by"},
+		}, nil
+	}
+
+	t.Run("copies fields and links the copy to the original", func(t *testing.T) {
+		t.Parallel()
+
+		var created []*jira4claude.Issue
+		var links []string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: clonerTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: "TEST-11"}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					links = append(links, inwardKey+" "+linkType+" "+outwardKey)
+					return nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1", Prefix: "CLONE - "}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 1)
+		assert.Equal(t, &jira4claude.Issue{
+			Project:     "TEST",
+			Type:        "Story",
+			Summary:     "CLONE - Weekly dependency bump",
+			Description: description,
+			Priority:    "High",
+			Labels:      []string{"chore"},
+			Components:  []string{"API"},
+			FixVersions: []string{"1.2"},
+			Parent:      &jira4claude.LinkedIssue{Key: "TEST-100"},
+		}, created[0])
+		assert.Equal(t, []string{"TEST-11 Cloners TEST-1"}, links)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Cloned TEST-1 as", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-11"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("uses given summary and project, leaving project-scoped fields behind", func(t *testing.T) {
+		t.Parallel()
+
+		var created []*jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: clonerTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: "BILL-1"}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1", Prefix: "CLONE - ", Summary: "Bump for billing", Project: "BILL"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 1)
+		assert.Equal(t, "Bump for billing", created[0].Summary)
+		assert.Equal(t, "BILL", created[0].Project)
+		assert.Empty(t, created[0].Components)
+		assert.Empty(t, created[0].FixVersions)
+	})
+
+	t.Run("recreates links in the same direction", func(t *testing.T) {
+		t.Parallel()
+
+		var links []string
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: clonerTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: "TEST-11"}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					links = append(links, inwardKey+" "+linkType+" "+outwardKey)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1", Links: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{
+			"TEST-11 Cloners TEST-1",
+			"TEST-11 Blocks TEST-7",
+			"TEST-8 Blocks TEST-11",
+		}, links)
+	})
+
+	t.Run("copies subtasks and epic children under the copy", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedFilter jira4claude.IssueFilter
+		var created []*jira4claude.Issue
+		var links []string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: clonerTypes,
+				ListFn: func(ctx context.Context, filter jira4claude.IssueFilter) ([]*jira4claude.Issue, error) {
+					capturedFilter = filter
+					return []*jira4claude.Issue{{Key: "TEST-2"}, {Key: "TEST-3"}}, nil
+				},
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+len(created))}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					links = append(links, inwardKey+" "+linkType+" "+outwardKey)
+					return nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1", Prefix: "CLONE - ", Subtasks: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST-1", capturedFilter.Parent)
+		require.Len(t, created, 3)
+		assert.Equal(t, "Bump Go modules", created[1].Summary)
+		assert.Equal(t, "Sub-task", created[1].Type)
+		assert.Equal(t, []string{"go"}, created[1].Labels)
+		assert.Equal(t, &jira4claude.LinkedIssue{Key: "TEST-11"}, created[1].Parent)
+		assert.Equal(t, "Story", created[2].Type)
+		assert.Equal(t, &jira4claude.LinkedIssue{Key: "TEST-11"}, created[2].Parent)
+		assert.Equal(t, []string{"TEST-11 Cloners TEST-1", "TEST-12 Cloners TEST-2", "TEST-13 Cloners TEST-3"}, links)
+		assert.Equal(t, []string{"TEST-11", "TEST-12", "TEST-13"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("adds configured watchers to every copy", func(t *testing.T) {
+		t.Parallel()
+
+		var watched []string
+		createdCount := 0
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: clonerTypes,
+				ListFn: func(ctx context.Context, filter jira4claude.IssueFilter) ([]*jira4claude.Issue, error) {
+					return []*jira4claude.Issue{{Key: "TEST-2"}}, nil
+				},
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					createdCount++
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+createdCount)}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					return nil
+				},
+				AddWatcherFn: func(ctx context.Context, key, accountID string) error {
+					watched = append(watched, key+" "+accountID)
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me"}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST", Create: jira4claude.CreateDefaults{Watchers: []string{"me"}}},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1", Subtasks: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"TEST-11 acc-me", "TEST-12 acc-me"}, watched)
+	})
+
+	t.Run("warns when the site has no Cloners link type", func(t *testing.T) {
+		t.Parallel()
+
+		printer := &mock.Printer{}
+		var created []*jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: "TEST-11"}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCloneCmd{Key: "TEST-1"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 1)
+		require.Len(t, printer.WarningCalls, 1)
+		assert.Contains(t, printer.WarningCalls[0], "no Cloners link type")
+	})
+}
+
+func TestIssueSplitCmd(t *testing.T) {
+	t.Parallel()
+
+	item := func(s string, nested ...any) map[string]any {
+		content := append([]any{map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": s}}}}, nested...)
+		return map[string]any{"type": "listItem", "content": content}
+	}
+	heading := func(s string) map[string]any {
+		return map[string]any{"type": "heading", "attrs": map[string]any{"level": 2}, "content": []any{map[string]any{"type": "text", "text": s}}}
+	}
+	nested := map[string]any{"type": "bulletList", "content": []any{item("detail")}}
+	checklist := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+		map[string]any{"type": "bulletList", "content": []any{item("Add endpoint", nested), item("Write docs"), item("Release")}},
+	}}
+	// getIssue returns a GetFn for an issue with the given description.
+	getIssue := func(description jira4claude.ADF) func(context.Context, string) (*jira4claude.Issue, error) {
+		return func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+			return &jira4claude.Issue{Key: key, Project: "TEST", Summary: "Big story", Description: description}, nil
+		}
+	}
+
+	t.Run("creates a subtask for each list item", func(t *testing.T) {
+		t.Parallel()
+
+		var created []*jira4claude.Issue
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(checklist),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+len(created))}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 3)
+		assert.Equal(t, &jira4claude.Issue{
+			Project:     "TEST",
+			Type:        "Sub-task",
+			Summary:     "Add endpoint",
+			Description: jira4claude.ADF{"type": "doc", "version": 1, "content": []any{nested}},
+			Parent:      &jira4claude.LinkedIssue{Key: "TEST-1"},
+		}, created[0])
+		assert.Equal(t, "Write docs", created[1].Summary)
+		assert.Nil(t, created[1].Description)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Split TEST-1 into", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-11", "TEST-12", "TEST-13"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("warns about items without text", func(t *testing.T) {
+		t.Parallel()
+
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+			map[string]any{"type": "bulletList", "content": []any{item("Goal"), item("  "), item("Release")}},
+		}}
+		var created []*jira4claude.Issue
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(description),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+len(created))}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "items"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 2)
+		assert.Equal(t, []string{"skipped part 2: it has no text to use as a summary"}, printer.WarningCalls)
+	})
+
+	t.Run("prefers sections in auto mode", func(t *testing.T) {
+		t.Parallel()
+
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+			map[string]any{"type": "bulletList", "content": []any{item("Goal")}},
+			heading("Backend"),
+			nested,
+			heading("Frontend"),
+		}}
+		var created []*jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(description),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+len(created))}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 2)
+		assert.Equal(t, "Backend", created[0].Summary)
+		assert.Equal(t, jira4claude.ADF{"type": "doc", "version": 1, "content": []any{nested}}, created[0].Description)
+		assert.Equal(t, "Frontend", created[1].Summary)
+	})
+
+	t.Run("uses items when asked even if sections exist", func(t *testing.T) {
+		t.Parallel()
+
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+			heading("Plan"),
+			map[string]any{"type": "bulletList", "content": []any{item("Goal")}},
+		}}
+		var created []*jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(description),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: "TEST-11"}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "items"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 1)
+		assert.Equal(t, "Goal", created[0].Summary)
+	})
+
+	t.Run("shortens summaries to the Jira limit", func(t *testing.T) {
+		t.Parallel()
+
+		long := strings.Repeat("word ", 60)
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+			map[string]any{"type": "bulletList", "content": []any{item(long)}},
+		}}
+		var created []*jira4claude.Issue
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(description),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					created = append(created, issue)
+					return &jira4claude.Issue{Key: "TEST-11"}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "items"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, created, 1)
+		assert.LessOrEqual(t, utf8.RuneCountInString(created[0].Summary), 255)
+		assert.True(t, strings.HasSuffix(created[0].Summary, "word…"))
+		require.Len(t, printer.WarningCalls, 1)
+		assert.Contains(t, printer.WarningCalls[0], "subtask 1: summary shortened to 255 characters")
+	})
+
+	t.Run("dry run prints drafts without creating", func(t *testing.T) {
+		t.Parallel()
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{GetFn: getIssue(checklist)}, // panics on Create
+			Printer: printer,
+			Converter: &mock.Converter{
+				ToMarkdownFn: func(adf jira4claude.ADF) (string, []string) {
+					return "- detail", nil
+				},
+			},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto", DryRun: true, BlockingChain: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Empty(t, printer.SuccessCalls)
+		require.Len(t, printer.DraftsCalls, 1)
+		drafts := printer.DraftsCalls[0]
+		require.Len(t, drafts, 3)
+		assert.Equal(t, "Add endpoint", drafts[0].Summary)
+		assert.Equal(t, "Sub-task", drafts[0].Type)
+		assert.Equal(t, "- detail", drafts[0].Description)
+		assert.Empty(t, drafts[1].Description)
+	})
+
+	t.Run("blocking chain links subtasks in order", func(t *testing.T) {
+		t.Parallel()
+
+		var links []string
+		createdCount := 0
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue(checklist),
+				LinkTypesFn: linkTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					createdCount++
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+createdCount)}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					links = append(links, inwardKey+" "+linkType+" "+outwardKey)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto", BlockingChain: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"TEST-11 Blocks TEST-12", "TEST-12 Blocks TEST-13"}, links)
+	})
+
+	t.Run("blocking chain without Blocks link type fails before creating", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(checklist),
+				LinkTypesFn: func(ctx context.Context) ([]*jira4claude.IssueLinkType, error) {
+					return []*jira4claude.IssueLinkType{{Name: "Relates", Outward: "relates to", Inward: "relates to"}}, nil
+				},
+			}, // panics on Create
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto", BlockingChain: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns validation error when nothing to split", func(t *testing.T) {
+		t.Parallel()
+
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+			map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "just prose"}}},
+		}}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{GetFn: getIssue(description)},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "TEST-1")
+	})
+
+	t.Run("adds configured watchers to each subtask", func(t *testing.T) {
+		t.Parallel()
+
+		var watched []string
+		createdCount := 0
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(checklist),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					createdCount++
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+createdCount)}, nil
+				},
+				AddWatcherFn: func(ctx context.Context, key, accountID string) error {
+					watched = append(watched, key+" "+accountID)
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me"}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST", Create: jira4claude.CreateDefaults{Watchers: []string{"me"}}},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"TEST-11 acc-me", "TEST-12 acc-me", "TEST-13 acc-me"}, watched)
+	})
+
+	t.Run("chains and watches created subtasks when a later create fails", func(t *testing.T) {
+		t.Parallel()
+
+		var links, watched []string
+		createdCount := 0
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue(checklist),
+				LinkTypesFn: linkTypes,
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					if createdCount == 2 {
+						return nil, &jira4claude.Error{Code: jira4claude.EForbidden, Message: "no permission"}
+					}
+					createdCount++
+					return &jira4claude.Issue{Key: fmt.Sprintf("TEST-%d", 10+createdCount)}, nil
+				},
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					links = append(links, inwardKey+" "+linkType+" "+outwardKey)
+					return nil
+				},
+				AddWatcherFn: func(ctx context.Context, key, accountID string) error {
+					watched = append(watched, key+" "+accountID)
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me"}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST", Create: jira4claude.CreateDefaults{Watchers: []string{"me"}}},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto", BlockingChain: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EForbidden, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "stopped after creating 2 of 3 subtasks (TEST-11, TEST-12)")
+		assert.Equal(t, []string{"TEST-11 Blocks TEST-12"}, links)
+		assert.Equal(t, []string{"TEST-11 acc-me", "TEST-12 acc-me"}, watched)
+		assert.Empty(t, printer.SuccessCalls)
+	})
+
+	t.Run("returns the error as is when the first create fails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: getIssue(checklist),
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					return nil, &jira4claude.Error{Code: jira4claude.EForbidden, Message: "no permission"}
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueSplitCmd{Key: "TEST-1", By: "auto"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, "no permission", err.Error())
+	})
+}
+
+func TestIssueCreateCmd_Defaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := func() *jira4claude.Config {
+		return &jira4claude.Config{
+			Project: "TEST",
+			Server:  "https://test.atlassian.net",
+			Create: jira4claude.CreateDefaults{
+				Type:       "Story",
+				Labels:     []string{"backend"},
+				Priority:   "Medium",
+				Components: []string{"API"},
+				Assignee:   "5b10ac8d82e05b22cc7d4ef5",
+				Templates:  map[string]string{"bug": "## Steps to reproduce"},
+			},
+		}
+	}
+
+	t.Run("falls back to Task without a configured type", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Task", capturedIssue.Type)
+	})
+
+	t.Run("applies configured defaults", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Story", capturedIssue.Type)
+		assert.Equal(t, "Medium", capturedIssue.Priority)
+		assert.Equal(t, []string{"backend"}, capturedIssue.Labels)
+		assert.Equal(t, []string{"API"}, capturedIssue.Components)
+		require.NotNil(t, capturedIssue.Assignee)
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", capturedIssue.Assignee.AccountID)
+		assert.Empty(t, capturedIssue.Description)
+	})
+
+	t.Run("flags override scalar defaults and extend list defaults", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    defaults(),
+		}
+		cmd := main.IssueCreateCmd{
+			Summary:     "Test issue",
+			Type:        "Task",
+			Priority:    "High",
+			Labels:      []string{"urgent", "backend"},
+			Components:  []string{"UI"},
+			FixVersions: []string{"1.2"},
+			Assignee:    "5b10ac8d82e05b22cc7d4ef6",
+		}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Task", capturedIssue.Type)
+		assert.Equal(t, "High", capturedIssue.Priority)
+		assert.Equal(t, []string{"backend", "urgent"}, capturedIssue.Labels)
+		assert.Equal(t, []string{"API", "UI"}, capturedIssue.Components)
+		assert.Equal(t, []string{"1.2"}, capturedIssue.FixVersions)
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef6", capturedIssue.Assignee.AccountID)
+	})
+
+	t.Run("resolves the configured assignee", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me", DisplayName: "Me"}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST", Create: jira4claude.CreateDefaults{Assignee: "me"}},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, capturedIssue.Assignee)
+		assert.Equal(t, "acc-me", capturedIssue.Assignee.AccountID)
+	})
+
+	t.Run("resolves the assignee flag by email", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Users: &mock.UserService{
+				SearchFn: func(ctx context.Context, query string) ([]*jira4claude.User, error) {
+					assert.Equal(t, "jane@example.com", query)
+					return []*jira4claude.User{{AccountID: "acc-jane", Email: "jane@example.com"}}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST", Create: jira4claude.CreateDefaults{Assignee: "me"}},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Assignee: "jane@example.com"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, capturedIssue.Assignee)
+		assert.Equal(t, "acc-jane", capturedIssue.Assignee.AccountID)
+	})
+
+	t.Run("does not create when the assignee cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{}, // panics on Create
+			Users: &mock.UserService{
+				SearchFn: func(ctx context.Context, query string) ([]*jira4claude.User, error) {
+					return nil, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Assignee: "nobody"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("adds configured and flagged watchers after creating", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					calls = append(calls, "create")
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+				AddWatcherFn: func(ctx context.Context, key, accountID string) error {
+					calls = append(calls, "watch "+key+" "+accountID)
+					if accountID == "5b10ac8d82e05b22cc7d4ef5" {
+						return &jira4claude.Error{Code: jira4claude.EForbidden, Message: "no permission"}
+					}
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me", DisplayName: "Jane Doe"}, nil
+				},
+			},
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config: &jira4claude.Config{
+				Project: "TEST",
+				Create:  jira4claude.CreateDefaults{Watchers: []string{"me"}},
+			},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Watchers: []string{"5b10ac8d82e05b22cc7d4ef5"}}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"create", "watch TEST-1 acc-me", "watch TEST-1 5b10ac8d82e05b22cc7d4ef5"}, calls)
+		require.Len(t, printer.WarningCalls, 1)
+		assert.Contains(t, printer.WarningCalls[0], "failed to add watcher 5b10ac8d82e05b22cc7d4ef5")
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Created:", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("does not create the issue when a watcher cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{},
+			Users: &mock.UserService{
+				SearchFn: func(ctx context.Context, query string) ([]*jira4claude.User, error) {
+					return nil, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Watchers: []string{"nobody"}}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("ignores configured watchers with no-defaults", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := defaults()
+		cfg.Create.Watchers = []string{"me"}
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    cfg,
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", NoDefaults: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Task", capturedIssue.Type)
+	})
+
+	t.Run("uses template for issue type when description is empty", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderTextFn: func(name, text string, data *jira4claude.TemplateData) (string, error) {
+					return text, nil
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "git: not a git repository"}
+				},
+			},
+			Config: defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Type: "Bug"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "doc", capturedIssue.Description["type"])
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "## Steps to reproduce")
+	})
+
+	t.Run("renders placeholders in the type template", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedName, capturedText string
+		var capturedData *jira4claude.TemplateData
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderTextFn: func(name, text string, data *jira4claude.TemplateData) (string, error) {
+					capturedName, capturedText, capturedData = name, text, data
+					return "## Login fails on fix-login", nil
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return &jira4claude.GitHead{Branch: "fix-login", Commit: "abc123"}, nil
+				},
+			},
+			Config: &jira4claude.Config{
+				Project: "TEST",
+				Create:  jira4claude.CreateDefaults{Templates: map[string]string{"bug": "## {{.Summary}} on {{.Branch}}"}},
+			},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Login fails", Type: "Bug"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "create.templates.bug", capturedName)
+		assert.Equal(t, "## {{.Summary}} on {{.Branch}}", capturedText)
+		assert.Equal(t, &jira4claude.TemplateData{
+			Summary: "Login fails",
+			Type:    "Bug",
+			Project: "TEST",
+			Branch:  "fix-login",
+			Commit:  "abc123",
+		}, capturedData)
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "Login fails on fix-login")
+	})
+
+	t.Run("description flag takes precedence over template", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderTextFn: func(name, text string, data *jira4claude.TemplateData) (string, error) {
+					return text, nil
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "git: not a git repository"}
+				},
+			},
+			Config: defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Type: "Bug", Description: "custom"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "custom")
+		assert.NotContains(t, fmt.Sprint(capturedIssue.Description), "Steps to reproduce")
+	})
+
+	t.Run("renders named template with git placeholders", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		var capturedName string
+		var capturedData *jira4claude.TemplateData
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderFn: func(name string, data *jira4claude.TemplateData) (string, error) {
+					capturedName = name
+					capturedData = data
+					return "## Context\n\nbranch " + data.Branch, nil
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return &jira4claude.GitHead{Branch: "fix-login", Commit: "abc123"}, nil
+				},
+			},
+			Config: defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Login fails", Type: "Bug", Template: "bug"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "bug", capturedName)
+		assert.Equal(t, &jira4claude.TemplateData{
+			Summary: "Login fails",
+			Type:    "Bug",
+			Project: "TEST",
+			Branch:  "fix-login",
+			Commit:  "abc123",
+		}, capturedData)
+		// The named template replaces the config template for the type
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "branch fix-login")
+		assert.NotContains(t, fmt.Sprint(capturedIssue.Description), "Steps to reproduce")
+	})
+
+	t.Run("renders template without git placeholders outside a repository", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedData *jira4claude.TemplateData
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderFn: func(name string, data *jira4claude.TemplateData) (string, error) {
+					capturedData = data
+					return "body", nil
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "git: not a git repository"}
+				},
+			},
+			Config: defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Spike", Template: "spike"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Empty(t, capturedData.Branch)
+		assert.Empty(t, capturedData.Commit)
+	})
+
+	t.Run("returns template errors", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service:   &mock.IssueService{},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Templates: &mock.TemplateService{
+				RenderFn: func(name string, data *jira4claude.TemplateData) (string, error) {
+					return "", &jira4claude.Error{Code: jira4claude.ENotFound, Message: "template \"bug\" not found"}
+				},
+			},
+			Git: &mock.GitService{
+				HeadFn: func(ctx context.Context) (*jira4claude.GitHead, error) {
+					return &jira4claude.GitHead{}, nil
+				},
+			},
+			Config: defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Bug", Template: "bug"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("no-defaults ignores configured defaults", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    defaults(),
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test issue", Type: "Bug", NoDefaults: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "Bug", capturedIssue.Type)
+		assert.Empty(t, capturedIssue.Priority)
+		assert.Empty(t, capturedIssue.Labels)
+		assert.Empty(t, capturedIssue.Components)
+		assert.Nil(t, capturedIssue.Assignee)
+		assert.Empty(t, capturedIssue.Description)
+	})
+}
+
+func TestIssueCreateCmd_TextInput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads description from stdin", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Stdin:     strings.NewReader("from `stdin`"),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test", Description: "-"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "from `stdin`")
+	})
+
+	t.Run("reads description from file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "desc.md")
+		require.NoError(t, os.WriteFile(path, []byte("from file"), 0o600))
+
+		var capturedIssue *jira4claude.Issue
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCreateCmd{Summary: "Test", DescriptionFile: path}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "from file")
+	})
+
+	t.Run("returns validation error for missing description file", func(t *testing.T) {
 		t.Parallel()
 
-		var capturedIssue *jira4claude.Issue
-		svc := &mock.IssueService{
-			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
-				capturedIssue = issue
-				return &jira4claude.Issue{Key: "TEST-1"}, nil
-			},
-		}
+		path := filepath.Join(t.TempDir(), "missing.md")
 
-		printer := &mock.Printer{}
 		ctx := &main.IssueContext{
-			Service:   svc,
-			Printer:   printer,
+			Service:   &mock.IssueService{},
+			Printer:   &mock.Printer{},
 			Converter: mockConverter(),
-			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
-		}
-		cmd := main.IssueCreateCmd{
-			Summary:     "Test issue",
-			Description: "",
+			Config:    &jira4claude.Config{Project: "TEST"},
 		}
+		cmd := main.IssueCreateCmd{Summary: "Test", DescriptionFile: path}
+
 		err := cmd.Run(ctx)
 
-		require.NoError(t, err)
-		require.NotNil(t, capturedIssue)
-		// Description should remain empty
-		assert.Empty(t, capturedIssue.Description)
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
 	})
 
-	t.Run("sets type to Sub-task when parent is specified", func(t *testing.T) {
+	t.Run("edits description in the editor", func(t *testing.T) {
 		t.Parallel()
 
+		var editorInput string
 		var capturedIssue *jira4claude.Issue
-		svc := &mock.IssueService{
-			CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
-				capturedIssue = issue
-				return &jira4claude.Issue{Key: "TEST-2"}, nil
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				CreateFn: func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
+					capturedIssue = issue
+					return &jira4claude.Issue{Key: "TEST-1"}, nil
+				},
 			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Editor: &mock.Editor{
+				EditFn: func(text string) (string, error) {
+					editorInput = text
+					return text + " edited", nil
+				},
+			},
+			Config: &jira4claude.Config{Project: "TEST"},
 		}
+		cmd := main.IssueCreateCmd{Summary: "Test", Description: "draft", Editor: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "draft", editorInput)
+		assert.Contains(t, fmt.Sprint(capturedIssue.Description), "draft edited")
+	})
+
+	t.Run("aborts when the editor returns empty text", func(t *testing.T) {
+		t.Parallel()
 
-		printer := &mock.Printer{}
 		ctx := &main.IssueContext{
-			Service:   svc,
-			Printer:   printer,
+			Service:   &mock.IssueService{}, // panics on Create
+			Printer:   &mock.Printer{},
 			Converter: mockConverter(),
-			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
-		}
-		cmd := main.IssueCreateCmd{
-			Summary: "Subtask issue",
-			Parent:  "TEST-1",
+			Editor: &mock.Editor{
+				EditFn: func(text string) (string, error) {
+					return "  \n", nil
+				},
+			},
+			Config: &jira4claude.Config{Project: "TEST"},
 		}
+		cmd := main.IssueCreateCmd{Summary: "Test", Editor: true}
+
 		err := cmd.Run(ctx)
 
-		require.NoError(t, err)
-		require.NotNil(t, capturedIssue)
-		// Type must be exactly "Sub-task" (with hyphen) for Jira API
-		assert.Equal(t, "Sub-task", capturedIssue.Type)
-		require.NotNil(t, capturedIssue.Parent)
-		assert.Equal(t, "TEST-1", capturedIssue.Parent.Key)
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
 	})
 }
 
@@ -410,6 +2262,58 @@ func TestIssueUpdateCmd(t *testing.T) {
 		assert.Equal(t, "EPIC-1", *capturedUpdate.Parent)
 	})
 
+	t.Run("resolves assignee display name", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedUpdate jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					capturedUpdate = update
+					return makeIssue(key), nil
+				},
+			},
+			Users: &mock.UserService{
+				AssignableFn: func(ctx context.Context, key, query string) ([]*jira4claude.User, error) {
+					return []*jira4claude.User{{AccountID: "acc-1", DisplayName: "Jane Doe"}}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		assignee := "jane"
+		cmd := main.IssueUpdateCmd{Key: "TEST-5", Assignee: &assignee}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, capturedUpdate.Assignee)
+		assert.Equal(t, "acc-1", *capturedUpdate.Assignee)
+	})
+
+	t.Run("unassigns with empty assignee without a lookup", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedUpdate jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					capturedUpdate = update
+					return makeIssue(key), nil
+				},
+			},
+			Users:     &mock.UserService{},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		assignee := ""
+		cmd := main.IssueUpdateCmd{Key: "TEST-5", Assignee: &assignee}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, capturedUpdate.Assignee)
+		assert.Empty(t, *capturedUpdate.Assignee)
+	})
+
 	t.Run("clears parent when clear-parent flag set", func(t *testing.T) {
 		t.Parallel()
 
@@ -436,31 +2340,391 @@ func TestIssueUpdateCmd(t *testing.T) {
 		assert.Empty(t, *capturedUpdate.Parent)
 	})
 
-	t.Run("does not modify parent when neither flag set", func(t *testing.T) {
+	t.Run("does not modify parent when neither flag set", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedUpdate jira4claude.IssueUpdate
+		svc := &mock.IssueService{
+			UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+				capturedUpdate = update
+				return makeIssue(key), nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
+		}
+		summary := "Updated summary"
+		cmd := main.IssueUpdateCmd{Key: "TEST-5", Summary: &summary}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		// Parent should be nil (no change)
+		assert.Nil(t, capturedUpdate.Parent)
+	})
+	t.Run("sends raw ADF from file without conversion", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "desc.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"doc","version":1,"content":[]}`), 0o600))
+
+		var capturedUpdate jira4claude.IssueUpdate
+		svc := &mock.IssueService{
+			UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+				capturedUpdate = update
+				return &jira4claude.Issue{Key: key}, nil
+			},
+		}
+
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{}, // panics if ToADF is called
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueUpdateCmd{
+			Key:     "TEST-1",
+			ADFFile: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, capturedUpdate.Description)
+		assert.Equal(t, "doc", (*capturedUpdate.Description)["type"])
+	})
+}
+
+func TestIssueUpdateCmd_TextInput(t *testing.T) {
+	t.Parallel()
+
+	currentDescription := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+		map[string]any{"type": "paragraph", "content": []any{
+			map[string]any{"type": "text", "text": "current"},
+		}},
+	}}
+
+	newCtx := func(captured *jira4claude.IssueUpdate, editFn func(string) (string, error)) *main.IssueContext {
+		return &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: currentDescription}, nil
+				},
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					*captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Editor:    &mock.Editor{EditFn: editFn},
+			Stdin:     strings.NewReader("from stdin"),
+		}
+	}
+
+	t.Run("reads description from stdin", func(t *testing.T) {
+		t.Parallel()
+
+		var update jira4claude.IssueUpdate
+		desc := "-"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc}
+
+		require.NoError(t, cmd.Run(newCtx(&update, nil)))
+
+		require.NotNil(t, update.Description)
+		assert.Contains(t, fmt.Sprint(*update.Description), "from stdin")
+	})
+
+	t.Run("editor starts from current description", func(t *testing.T) {
+		t.Parallel()
+
+		var update jira4claude.IssueUpdate
+		var editorInput string
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Editor: true}
+
+		err := cmd.Run(newCtx(&update, func(text string) (string, error) {
+			editorInput = text
+			return "rewritten", nil
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "current", editorInput)
+		require.NotNil(t, update.Description)
+		assert.Contains(t, fmt.Sprint(*update.Description), "rewritten")
+	})
+
+	t.Run("leaves description unchanged when editor makes no changes", func(t *testing.T) {
+		t.Parallel()
+
+		var update jira4claude.IssueUpdate
+		summary := "New summary"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Summary: &summary, Editor: true}
+
+		err := cmd.Run(newCtx(&update, func(text string) (string, error) {
+			return text, nil
+		}))
+
+		require.NoError(t, err)
+		assert.Nil(t, update.Description)
+		assert.Equal(t, &summary, update.Summary)
+	})
+}
+
+func TestIssueUpdateCmd_IncrementalLists(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes add and remove flags through", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueUpdateCmd{
+			Key:               "TEST-1",
+			AddLabels:         []string{"urgent"},
+			RemoveLabels:      []string{"triage"},
+			AddComponents:     []string{"API"},
+			RemoveComponents:  []string{"UI"},
+			AddFixVersions:    []string{"2.0"},
+			RemoveFixVersions: []string{"1.0"},
+		}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Nil(t, captured.Labels)
+		assert.Equal(t, []string{"urgent"}, captured.AddLabels)
+		assert.Equal(t, []string{"triage"}, captured.RemoveLabels)
+		assert.Equal(t, []string{"API"}, captured.AddComponents)
+		assert.Equal(t, []string{"UI"}, captured.RemoveComponents)
+		assert.Equal(t, []string{"2.0"}, captured.AddFixVersions)
+		assert.Equal(t, []string{"1.0"}, captured.RemoveFixVersions)
+	})
+
+	t.Run("replaces components and versions", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+		}
+		cmd := main.IssueUpdateCmd{
+			Key:             "TEST-1",
+			Components:      []string{"API"},
+			AffectsVersions: []string{"1.0"},
+			FixVersions:     []string{"1.1"},
+		}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, captured.Components)
+		assert.Equal(t, []string{"API"}, *captured.Components)
+		require.NotNil(t, captured.AffectsVersions)
+		assert.Equal(t, []string{"1.0"}, *captured.AffectsVersions)
+		require.NotNil(t, captured.FixVersions)
+		assert.Equal(t, []string{"1.1"}, *captured.FixVersions)
+	})
+}
+
+func TestIssueUpdateCmd_Splice(t *testing.T) {
+	t.Parallel()
+
+	panel := map[string]any{"type": "panel", "attrs": map[string]any{"panelType": "info"}, "content": []any{
+		map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "keep"}}},
+	}}
+	findings := map[string]any{"type": "heading", "attrs": map[string]any{"level": 2}, "content": []any{
+		map[string]any{"type": "text", "text": "Findings"},
+	}}
+	current := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{
+		panel,
+		findings,
+		map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "old"}}},
+	}}
+
+	t.Run("appends without converting the current description", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: current}, nil
+				},
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{ToADFFn: mockConverter().ToADFFn}, // panics on ToMarkdown
+		}
+		desc := "new"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc, Append: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NotNil(t, captured.Description)
+		content := (*captured.Description)["content"].([]any)
+		require.Len(t, content, 4)
+		assert.Equal(t, panel, content[0])
+		assert.Contains(t, fmt.Sprint(content[3]), "new")
+	})
+
+	t.Run("prepends before the current description", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: current}, nil
+				},
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{ToADFFn: mockConverter().ToADFFn},
+		}
+		desc := "note"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc, Prepend: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		content := (*captured.Description)["content"].([]any)
+		require.Len(t, content, 4)
+		assert.Contains(t, fmt.Sprint(content[0]), "note")
+		assert.Equal(t, panel, content[1])
+	})
+
+	t.Run("replaces a named section", func(t *testing.T) {
+		t.Parallel()
+
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: current}, nil
+				},
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{ToADFFn: mockConverter().ToADFFn},
+		}
+		desc := "replacement"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc, ReplaceSection: "Findings"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		content := (*captured.Description)["content"].([]any)
+		require.Len(t, content, 3)
+		assert.Equal(t, panel, content[0])
+		assert.Equal(t, findings, content[1])
+		assert.Contains(t, fmt.Sprint(content[2]), "replacement")
+	})
+
+	t.Run("does not validate the current description", func(t *testing.T) {
+		t.Parallel()
+
+		// Jira accepted this heading without a level, so it is kept as is.
+		legacy := map[string]any{"type": "heading", "content": []any{map[string]any{"type": "text", "text": "Legacy"}}}
+		var captured jira4claude.IssueUpdate
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: jira4claude.ADF{"type": "doc", "version": 1, "content": []any{legacy}}}, nil
+				},
+				UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
+					captured = update
+					return &jira4claude.Issue{Key: key}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{ToADFFn: mockConverter().ToADFFn},
+		}
+		desc := "new"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc, Append: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		content := (*captured.Description)["content"].([]any)
+		require.Len(t, content, 2)
+		assert.Equal(t, legacy, content[0])
+	})
+
+	t.Run("validates the new content before fetching the issue", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "fragment.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"type":"doc","version":1,"content":[{"type":"heading","content":[]}]}`), 0o644))
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{}, // panics on Get and Update
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", ADFFile: path, Append: true}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns not found for unknown section", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+					return &jira4claude.Issue{Key: key, Description: current}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{ToADFFn: mockConverter().ToADFFn},
+		}
+		desc := "replacement"
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Description: &desc, ReplaceSection: "Summary"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns validation error without a description", func(t *testing.T) {
 		t.Parallel()
 
-		var capturedUpdate jira4claude.IssueUpdate
-		svc := &mock.IssueService{
-			UpdateFn: func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error) {
-				capturedUpdate = update
-				return makeIssue(key), nil
-			},
-		}
-
-		printer := &mock.Printer{}
 		ctx := &main.IssueContext{
-			Service:   svc,
-			Printer:   printer,
-			Converter: mockConverter(),
-			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
+			Service: &mock.IssueService{},
+			Printer: &mock.Printer{},
 		}
-		summary := "Updated summary"
-		cmd := main.IssueUpdateCmd{Key: "TEST-5", Summary: &summary}
+		cmd := main.IssueUpdateCmd{Key: "TEST-1", Append: true}
+
 		err := cmd.Run(ctx)
 
-		require.NoError(t, err)
-		// Parent should be nil (no change)
-		assert.Nil(t, capturedUpdate.Parent)
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
 	})
 }
 
@@ -530,6 +2794,100 @@ func TestIssueCommentCmd(t *testing.T) {
 		// Plain text is valid GFM and should be converted to ADF
 		assert.Equal(t, "doc", capturedBody["type"])
 	})
+	t.Run("sends raw ADF body from file without conversion", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "body.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"doc","version":1,"content":[]}`), 0o600))
+
+		var capturedBody jira4claude.ADF
+		svc := &mock.IssueService{
+			AddCommentFn: func(ctx context.Context, key string, body jira4claude.ADF) (*jira4claude.Comment, error) {
+				capturedBody = body
+				return &jira4claude.Comment{ID: "10001"}, nil
+			},
+		}
+
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{}, // panics if ToADF is called
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCommentCmd{
+			Key:     "TEST-1",
+			BodyADF: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "doc", capturedBody["type"])
+	})
+	t.Run("rejects invalid ADF before calling the service", func(t *testing.T) {
+		t.Parallel()
+
+		adfPath := filepath.Join(t.TempDir(), "body.json")
+		require.NoError(t, os.WriteFile(adfPath, []byte(`{"type":"doc","version":1,"content":[{"type":"heading","content":[{"type":"text","text":"x"}]}]}`), 0o600))
+
+		ctx := &main.IssueContext{
+			Service:   &mock.IssueService{}, // panics if AddComment is called
+			Printer:   &mock.Printer{},
+			Converter: &mock.Converter{},
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueCommentCmd{
+			Key:     "TEST-1",
+			BodyADF: adfPath,
+		}
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `content[0]: heading requires attr "level"`)
+	})
+}
+
+func TestIssueCommentCmd_TextInput(t *testing.T) {
+	t.Parallel()
+
+	newCtx := func(captured *jira4claude.ADF) *main.IssueContext {
+		return &main.IssueContext{
+			Service: &mock.IssueService{
+				AddCommentFn: func(ctx context.Context, key string, body jira4claude.ADF) (*jira4claude.Comment, error) {
+					*captured = body
+					return &jira4claude.Comment{ID: "1"}, nil
+				},
+			},
+			Printer:   &mock.Printer{},
+			Converter: mockConverter(),
+			Stdin:     strings.NewReader("body from stdin"),
+		}
+	}
+
+	t.Run("reads body from stdin", func(t *testing.T) {
+		t.Parallel()
+
+		var body jira4claude.ADF
+		cmd := main.IssueCommentCmd{Key: "TEST-1", Body: "-"}
+
+		require.NoError(t, cmd.Run(newCtx(&body)))
+
+		assert.Contains(t, fmt.Sprint(body), "body from stdin")
+	})
+
+	t.Run("reads body from file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "comment.md")
+		require.NoError(t, os.WriteFile(path, []byte("body from file"), 0o600))
+
+		var body jira4claude.ADF
+		cmd := main.IssueCommentCmd{Key: "TEST-1", BodyFile: path}
+
+		require.NoError(t, cmd.Run(newCtx(&body)))
+
+		assert.Contains(t, fmt.Sprint(body), "body from file")
+	})
 }
 
 // IssueReadyCmd tests
@@ -863,6 +3221,7 @@ func TestIssueViewCmd(t *testing.T) {
 					},
 				}, nil
 			},
+			RemoteLinksFn: noRemoteLinks,
 		}
 
 		printer := &mock.Printer{}
@@ -894,6 +3253,7 @@ func TestIssueViewCmd(t *testing.T) {
 					Description: nil,
 				}, nil
 			},
+			RemoteLinksFn: noRemoteLinks,
 		}
 
 		printer := &mock.Printer{}
@@ -953,6 +3313,7 @@ func TestIssueViewCmd(t *testing.T) {
 					},
 				}, nil
 			},
+			RemoteLinksFn: noRemoteLinks,
 		}
 
 		printer := &mock.Printer{}
@@ -971,6 +3332,125 @@ func TestIssueViewCmd(t *testing.T) {
 		// Comment body should contain the text after conversion
 		assert.Contains(t, printer.IssueCalls[0].Comments[0].Body, "Comment with ")
 	})
+	t.Run("includes raw ADF when requested", func(t *testing.T) {
+		t.Parallel()
+
+		description := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{}}
+		commentBody := jira4claude.ADF{"type": "doc", "version": 1, "content": []any{}}
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{
+					Key:         "TEST-1",
+					Description: description,
+					Comments:    []*jira4claude.Comment{{ID: "10001", Body: commentBody}},
+				}, nil
+			},
+			RemoteLinksFn: noRemoteLinks,
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueViewCmd{Key: "TEST-1", RawADF: true}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, printer.IssueCalls, 1)
+		assert.Equal(t, description, printer.IssueCalls[0].DescriptionADF)
+		require.Len(t, printer.IssueCalls[0].Comments, 1)
+		assert.Equal(t, commentBody, printer.IssueCalls[0].Comments[0].BodyADF)
+	})
+
+	t.Run("omits raw ADF by default", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{
+					Key:         "TEST-1",
+					Description: jira4claude.ADF{"type": "doc", "version": 1, "content": []any{}},
+				}, nil
+			},
+			RemoteLinksFn: noRemoteLinks,
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueViewCmd{Key: "TEST-1"}
+		err := cmd.Run(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, printer.IssueCalls, 1)
+		assert.Nil(t, printer.IssueCalls[0].DescriptionADF)
+	})
+
+	t.Run("includes web links in related issues", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{Key: key}, nil
+			},
+			RemoteLinksFn: func(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+				return []*jira4claude.RemoteLink{{ID: "10000", URL: "https://github.com/org/repo/pull/42", Title: "PR #42", Relationship: "pull request"}}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueViewCmd{Key: "TEST-1"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, printer.IssueCalls, 1)
+		assert.Equal(t, []jira4claude.RelatedIssueView{
+			{Relationship: "pull request", Summary: "PR #42", URL: "https://github.com/org/repo/pull/42"},
+		}, printer.IssueCalls[0].RelatedIssues)
+	})
+
+	t.Run("warns and still shows the issue when web links fail to load", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{Key: key, Summary: "Test"}, nil
+			},
+			RemoteLinksFn: func(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+				return nil, &jira4claude.Error{Code: jira4claude.EForbidden, Message: "no permission"}
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service:   svc,
+			Printer:   printer,
+			Converter: mockConverter(),
+			Config:    &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.IssueViewCmd{Key: "TEST-1"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, printer.IssueCalls, 1)
+		assert.Equal(t, "Test", printer.IssueCalls[0].Summary)
+		assert.Empty(t, printer.IssueCalls[0].RelatedIssues)
+		require.Len(t, printer.WarningCalls, 1)
+		assert.Contains(t, printer.WarningCalls[0], "failed to load web links: no permission")
+	})
 }
 
 // IssueListCmd tests
@@ -1074,12 +3554,14 @@ func TestIssueListCmd(t *testing.T) {
 			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
 		}
 		cmd := main.IssueListCmd{
-			Project:  "MYPROJ",
-			Status:   "In Progress",
-			Assignee: "john.doe",
-			Parent:   "MYPROJ-1",
-			Labels:   []string{"bug", "urgent"},
-			Limit:    25,
+			Project:    "MYPROJ",
+			Status:     "In Progress",
+			Assignee:   "john.doe",
+			Parent:     "MYPROJ-1",
+			Labels:     []string{"bug", "urgent"},
+			Component:  "API",
+			FixVersion: "1.2",
+			Limit:      25,
 		}
 		err := cmd.Run(ctx)
 
@@ -1089,6 +3571,8 @@ func TestIssueListCmd(t *testing.T) {
 		assert.Equal(t, "john.doe", capturedFilter.Assignee)
 		assert.Equal(t, "MYPROJ-1", capturedFilter.Parent)
 		assert.Equal(t, []string{"bug", "urgent"}, capturedFilter.Labels)
+		assert.Equal(t, "API", capturedFilter.Component)
+		assert.Equal(t, "1.2", capturedFilter.FixVersion)
 		assert.Equal(t, 25, capturedFilter.Limit)
 	})
 
@@ -1281,7 +3765,7 @@ func TestIssueAssignCmd(t *testing.T) {
 			Converter: mockConverter(),
 			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
 		}
-		cmd := main.IssueAssignCmd{Key: "TEST-1", AccountID: "abc123"}
+		cmd := main.IssueAssignCmd{Key: "TEST-1", Assignee: "5b10ac8d82e05b22cc7d4ef5"}
 		err := cmd.Run(ctx)
 
 		require.NoError(t, err)
@@ -1290,7 +3774,7 @@ func TestIssueAssignCmd(t *testing.T) {
 		assert.Equal(t, []string{"TEST-1"}, printer.SuccessCalls[0].Keys)
 	})
 
-	t.Run("prints unassign message when account ID is empty", func(t *testing.T) {
+	t.Run("prints unassign message when assignee is empty", func(t *testing.T) {
 		t.Parallel()
 
 		svc := &mock.IssueService{
@@ -1306,7 +3790,7 @@ func TestIssueAssignCmd(t *testing.T) {
 			Converter: mockConverter(),
 			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
 		}
-		cmd := main.IssueAssignCmd{Key: "TEST-1", AccountID: ""}
+		cmd := main.IssueAssignCmd{Key: "TEST-1", Assignee: ""}
 		err := cmd.Run(ctx)
 
 		require.NoError(t, err)
@@ -1331,7 +3815,195 @@ func TestIssueAssignCmd(t *testing.T) {
 			Converter: mockConverter(),
 			Config:    &jira4claude.Config{Project: "TEST", Server: "https://test.atlassian.net"},
 		}
-		cmd := main.IssueAssignCmd{Key: "NOTFOUND-1", AccountID: "abc123"}
+		cmd := main.IssueAssignCmd{Key: "NOTFOUND-1", Assignee: "5b10ac8d82e05b22cc7d4ef5"}
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("resolves me to the authenticated user", func(t *testing.T) {
+		t.Parallel()
+
+		var assigned string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				AssignFn: func(ctx context.Context, key, accountID string) error {
+					assigned = accountID
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+					return &jira4claude.User{AccountID: "acc-me", DisplayName: "Jane Doe"}, nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.IssueAssignCmd{Key: "TEST-1", Assignee: "me"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "acc-me", assigned)
+		assert.Equal(t, "Assigned to Jane Doe:", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("resolves email among assignable users", func(t *testing.T) {
+		t.Parallel()
+
+		var assigned, searchedKey string
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				AssignFn: func(ctx context.Context, key, accountID string) error {
+					assigned = accountID
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				AssignableFn: func(ctx context.Context, key, query string) ([]*jira4claude.User, error) {
+					searchedKey = key
+					return []*jira4claude.User{
+						{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+						{AccountID: "acc-2", DisplayName: "Jane Smith", Email: "jane.smith@example.com"},
+					}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueAssignCmd{Key: "TEST-1", Assignee: "Jane@Example.com"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST-1", searchedKey)
+		assert.Equal(t, "acc-1", assigned)
+	})
+}
+
+func TestIssueWatchCmd(t *testing.T) {
+	t.Parallel()
+
+	me := &mock.UserService{
+		MeFn: func(ctx context.Context) (*jira4claude.User, error) {
+			return &jira4claude.User{AccountID: "acc-me", DisplayName: "Jane Doe"}, nil
+		},
+	}
+
+	t.Run("adds the authenticated user by default", func(t *testing.T) {
+		t.Parallel()
+
+		var watchedKey, watcher string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				AddWatcherFn: func(ctx context.Context, key, accountID string) error {
+					watchedKey, watcher = key, accountID
+					return nil
+				},
+			},
+			Users:   me,
+			Printer: printer,
+		}
+		cmd := main.IssueWatchCmd{Key: "TEST-1", User: "me"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST-1", watchedKey)
+		assert.Equal(t, "acc-me", watcher)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Added watcher Jane Doe to", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("removes a watcher found by email", func(t *testing.T) {
+		t.Parallel()
+
+		var removed string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				RemoveWatcherFn: func(ctx context.Context, key, accountID string) error {
+					removed = accountID
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				SearchFn: func(ctx context.Context, query string) ([]*jira4claude.User, error) {
+					return []*jira4claude.User{{AccountID: "acc-2", DisplayName: "John Roe", Email: query}}, nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.IssueUnwatchCmd{Key: "TEST-1", User: "john@example.com"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "acc-2", removed)
+		assert.Equal(t, "Removed watcher John Roe from", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("lists watchers", func(t *testing.T) {
+		t.Parallel()
+
+		watchers := []*jira4claude.User{{AccountID: "acc-1", DisplayName: "Jane Doe"}}
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				WatchersFn: func(ctx context.Context, key string) ([]*jira4claude.User, error) {
+					return watchers, nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.IssueWatchersCmd{Key: "TEST-1"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, printer.UsersCalls, 1)
+		assert.Equal(t, watchers, printer.UsersCalls[0])
+	})
+
+	t.Run("votes and removes the vote", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		printer := &mock.Printer{}
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				VoteFn: func(ctx context.Context, key string) error {
+					calls = append(calls, "vote "+key)
+					return nil
+				},
+				UnvoteFn: func(ctx context.Context, key string) error {
+					calls = append(calls, "unvote "+key)
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+
+		require.NoError(t, (&main.IssueVoteCmd{Key: "TEST-1"}).Run(ctx))
+		require.NoError(t, (&main.IssueUnvoteCmd{Key: "TEST-1"}).Run(ctx))
+
+		assert.Equal(t, []string{"vote TEST-1", "unvote TEST-1"}, calls)
+		require.Len(t, printer.SuccessCalls, 2)
+		assert.Equal(t, "Voted for", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, "Removed vote from", printer.SuccessCalls[1].Msg)
+	})
+
+	t.Run("returns error when user cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{},
+			Users: &mock.UserService{
+				SearchFn: func(ctx context.Context, query string) ([]*jira4claude.User, error) {
+					return nil, nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueWatchCmd{Key: "TEST-1", User: "nobody"}
+
 		err := cmd.Run(ctx)
 
 		require.Error(t, err)
@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/fwojciec/jira4claude"
 )
@@ -9,43 +12,168 @@ import (
 // LinkCmd groups link subcommands.
 type LinkCmd struct {
 	Create LinkCreateCmd `cmd:"" help:"Create a link between issues"`
-	Delete LinkDeleteCmd `cmd:"" help:"Delete a link between issues"`
+	Delete LinkDeleteCmd `cmd:"" help:"Delete links between issues"`
 	List   LinkListCmd   `cmd:"" help:"List links for an issue"`
+	Web    LinkWebCmd    `cmd:"" help:"Link an issue to a URL (pull request, CI run, document)"`
+	Types  LinkTypesCmd  `cmd:"" help:"List available link types"`
 }
 
 // LinkCreateCmd creates a link.
 type LinkCreateCmd struct {
 	InwardKey  string `arg:"" help:"Source issue key"`
-	LinkType   string `arg:"" help:"Link type (e.g., Blocks, Clones, Relates)"`
+	LinkType   string `arg:"" help:"Link type name or description (e.g., Blocks, 'is blocked by', duplicates); see link types"`
 	OutwardKey string `arg:"" help:"Target issue key"`
 }
 
-// Run executes the create link command.
+// Run executes the create link command. The link type is resolved against
+// the site's link types; an inward description such as "is blocked by"
+// swaps the issues so the link reads as typed.
 func (c *LinkCreateCmd) Run(ctx *LinkContext) error {
-	if err := ctx.Service.Link(context.Background(), c.InwardKey, c.LinkType, c.OutwardKey); err != nil {
+	types, err := ctx.Service.LinkTypes(context.Background())
+	if err != nil {
+		return err
+	}
+	linkType, reversed, err := jira4claude.ResolveLinkType(types, c.LinkType)
+	if err != nil {
 		return err
 	}
 
-	ctx.Printer.Success("Linked "+c.InwardKey+" "+c.LinkType, c.OutwardKey)
+	from, to := c.InwardKey, c.OutwardKey
+	if reversed {
+		from, to = to, from
+	}
+	if err := ctx.Service.Link(context.Background(), from, linkType.Name, to); err != nil {
+		return err
+	}
+
+	ctx.Printer.Success("Linked "+from+" "+linkType.Outward, to)
 	return nil
 }
 
-// LinkDeleteCmd deletes a link.
+// LinkTypesCmd lists the link types configured on the site.
+type LinkTypesCmd struct{}
+
+// Run executes the link types command.
+func (c *LinkTypesCmd) Run(ctx *LinkContext) error {
+	types, err := ctx.Service.LinkTypes(context.Background())
+	if err != nil {
+		return err
+	}
+	ctx.Printer.LinkTypes(types)
+	return nil
+}
+
+// LinkDeleteCmd deletes links between two issues, or a single link by ID.
 type LinkDeleteCmd struct {
-	Key1 string `arg:"" help:"First issue key"`
-	Key2 string `arg:"" help:"Second issue key"`
+	Key1 string `arg:"" optional:"" help:"First issue key"`
+	Key2 string `arg:"" optional:"" help:"Second issue key"`
+	Type string `help:"Only delete links of this type; a type name such as 'Blocks' matches both directions, a description such as 'is blocked by' only links reading that way from the first issue" short:"t"`
+	ID   string `help:"Delete the link with this ID (shown as linkId by link list --json)" name:"id"`
+	All  bool   `help:"Delete every matching link instead of failing when there are several"`
 }
 
 // Run executes the delete link command.
 func (c *LinkDeleteCmd) Run(ctx *LinkContext) error {
-	if err := ctx.Service.Unlink(context.Background(), c.Key1, c.Key2); err != nil {
+	if c.ID != "" {
+		if c.Key1 != "" || c.Type != "" || c.All {
+			return &jira4claude.Error{Code: jira4claude.EValidation, Message: "--id cannot be combined with issue keys, --type or --all"}
+		}
+		if err := ctx.Service.DeleteLink(context.Background(), c.ID); err != nil {
+			return err
+		}
+		ctx.Printer.Success("Deleted link " + c.ID)
+		return nil
+	}
+	if c.Key1 == "" || c.Key2 == "" {
+		return &jira4claude.Error{Code: jira4claude.EValidation, Message: "two issue keys or --id are required"}
+	}
+
+	links, err := c.matchingLinks(ctx)
+	if err != nil {
 		return err
 	}
+	if len(links) > 1 && !c.All {
+		described := make([]string, len(links))
+		for i, link := range links {
+			described[i] = describeLink(c.Key1, link) + " (" + link.ID + ")"
+		}
+		return &jira4claude.Error{
+			Code:    jira4claude.EConflict,
+			Message: c.Key1 + " and " + c.Key2 + " have " + strconv.Itoa(len(links)) + " matching links: " + strings.Join(described, ", ") + "; use --type, --id or --all",
+		}
+	}
+
+	for _, link := range links {
+		if err := ctx.Service.DeleteLink(context.Background(), link.ID); err != nil {
+			return err
+		}
+	}
 
-	ctx.Printer.Success("Unlinked "+c.Key1+" and", c.Key2)
+	if len(links) == 1 {
+		ctx.Printer.Success("Unlinked "+c.Key1+" and", c.Key2)
+	} else {
+		ctx.Printer.Success("Deleted "+strconv.Itoa(len(links))+" links between "+c.Key1+" and", c.Key2)
+	}
 	return nil
 }
 
+// matchingLinks returns the links of Key1 that connect it to Key2 and match
+// the type filter, if any. A filter naming a type matches links in either
+// direction; one giving a description of an asymmetric type only matches
+// links that read that way from Key1.
+func (c *LinkDeleteCmd) matchingLinks(ctx *LinkContext) ([]*jira4claude.IssueLink, error) {
+	var linkType *jira4claude.IssueLinkType
+	var directed, reversed bool
+	if c.Type != "" {
+		types, err := ctx.Service.LinkTypes(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		if linkType, reversed, err = jira4claude.ResolveLinkType(types, c.Type); err != nil {
+			return nil, err
+		}
+		directed = !strings.EqualFold(c.Type, linkType.Name) && linkType.Inward != linkType.Outward
+	}
+
+	issue, err := ctx.Service.Get(context.Background(), c.Key1)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []*jira4claude.IssueLink
+	for _, link := range issue.Links {
+		outward := link.OutwardIssue != nil && strings.EqualFold(link.OutwardIssue.Key, c.Key2)
+		inward := link.InwardIssue != nil && strings.EqualFold(link.InwardIssue.Key, c.Key2)
+		switch {
+		case !outward && !inward:
+			continue
+		case linkType != nil && link.Type.Name != linkType.Name:
+			continue
+		case directed && reversed != inward:
+			continue
+		}
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		msg := "no link found between " + c.Key1 + " and " + c.Key2
+		if c.Type != "" {
+			msg += " matching type " + strconv.Quote(c.Type)
+		}
+		return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+	}
+	return links, nil
+}
+
+// describeLink describes a link as it reads from the issue with the given key,
+// e.g. "PROJ-1 is blocked by PROJ-2".
+func describeLink(key string, link *jira4claude.IssueLink) string {
+	if link.OutwardIssue != nil {
+		return key + " " + link.Type.Outward + " " + link.OutwardIssue.Key
+	}
+	return key + " " + link.Type.Inward + " " + link.InwardIssue.Key
+}
+
 // LinkListCmd lists links for an issue.
 type LinkListCmd struct {
 	Key string `arg:"" help:"Issue key"`
@@ -58,7 +186,41 @@ func (c *LinkListCmd) Run(ctx *LinkContext) error {
 		return err
 	}
 
+	// As in issue view, the issue links are still listed without web links.
+	remote, err := ctx.Service.RemoteLinks(context.Background(), c.Key)
+	if err != nil {
+		ctx.Printer.Warning("failed to load web links: " + err.Error())
+	}
+
 	links := jira4claude.ToLinksView(issue.Links)
+	links = append(links, jira4claude.ToRemoteLinksView(remote)...)
 	ctx.Printer.Links(c.Key, links)
 	return nil
 }
+
+// LinkWebCmd links an issue to a web resource.
+type LinkWebCmd struct {
+	Key          string `arg:"" help:"Issue key"`
+	URL          string `arg:"" help:"URL to link (http or https)" name:"url"`
+	Title        string `help:"Link title (default: the URL)" short:"t"`
+	Relationship string `help:"Relationship shown in Jira (e.g., 'pull request', 'CI run')" short:"r"`
+}
+
+// Run executes the web link command.
+func (c *LinkWebCmd) Run(ctx *LinkContext) error {
+	if u, err := url.Parse(c.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &jira4claude.Error{Code: jira4claude.EValidation, Message: "invalid URL " + strconv.Quote(c.URL) + ": must be an absolute http or https URL"}
+	}
+
+	link, err := ctx.Service.AddRemoteLink(context.Background(), c.Key, &jira4claude.RemoteLink{
+		URL:          c.URL,
+		Title:        c.Title,
+		Relationship: c.Relationship,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Printer.Success("Linked "+link.URL+" to", c.Key)
+	return nil
+}
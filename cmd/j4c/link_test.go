@@ -11,6 +11,385 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// noRemoteLinks is a RemoteLinksFn for issues without web links.
+func noRemoteLinks(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+	return nil, nil
+}
+
+// linkTypes is a LinkTypesFn returning a typical set of site link types.
+func linkTypes(ctx context.Context) ([]*jira4claude.IssueLinkType, error) {
+	return []*jira4claude.IssueLinkType{
+		{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+		{Name: "Duplicate", Outward: "duplicates", Inward: "is duplicated by"},
+		{Name: "Relates", Outward: "relates to", Inward: "relates to"},
+	}, nil
+}
+
+// LinkCreateCmd tests
+
+func TestLinkCreateCmd(t *testing.T) {
+	t.Parallel()
+
+	type linkCall struct{ From, Type, To string }
+
+	t.Run("resolves type name case-insensitively", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []linkCall
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				LinkTypesFn: linkTypes,
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					calls = append(calls, linkCall{inwardKey, linkType, outwardKey})
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.LinkCreateCmd{InwardKey: "TEST-1", LinkType: "blocks", OutwardKey: "TEST-2"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []linkCall{{"TEST-1", "Blocks", "TEST-2"}}, calls)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Linked TEST-1 blocks", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-2"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("swaps issues for an inward description", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []linkCall
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				LinkTypesFn: linkTypes,
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					calls = append(calls, linkCall{inwardKey, linkType, outwardKey})
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.LinkCreateCmd{InwardKey: "TEST-1", LinkType: "is blocked by", OutwardKey: "TEST-2"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []linkCall{{"TEST-2", "Blocks", "TEST-1"}}, calls)
+		assert.Equal(t, "Linked TEST-2 blocks", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-1"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("resolves outward description", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []linkCall
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				LinkTypesFn: linkTypes,
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					calls = append(calls, linkCall{inwardKey, linkType, outwardKey})
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkCreateCmd{InwardKey: "TEST-1", LinkType: "Duplicates", OutwardKey: "TEST-2"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []linkCall{{"TEST-1", "Duplicate", "TEST-2"}}, calls)
+	})
+
+	t.Run("rejects unknown type listing valid types", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []linkCall
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				LinkTypesFn: linkTypes,
+				LinkFn: func(ctx context.Context, inwardKey, linkType, outwardKey string) error {
+					calls = append(calls, linkCall{inwardKey, linkType, outwardKey})
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkCreateCmd{InwardKey: "TEST-1", LinkType: "Causes", OutwardKey: "TEST-2"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "Blocks (blocks / is blocked by)")
+		assert.Empty(t, calls)
+	})
+}
+
+func TestLinkTypesCmd(t *testing.T) {
+	t.Parallel()
+
+	printer := &mock.Printer{}
+	ctx := &main.LinkContext{
+		Service: &mock.IssueService{LinkTypesFn: linkTypes},
+		Printer: printer,
+	}
+
+	require.NoError(t, (&main.LinkTypesCmd{}).Run(ctx))
+
+	require.Len(t, printer.LinkTypesCalls, 1)
+	assert.Len(t, printer.LinkTypesCalls[0], 3)
+	assert.Equal(t, "Blocks", printer.LinkTypesCalls[0][0].Name)
+}
+
+// LinkDeleteCmd tests
+
+func TestLinkDeleteCmd(t *testing.T) {
+	t.Parallel()
+
+	blocks := jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}
+	relates := jira4claude.IssueLinkType{Name: "Relates", Outward: "relates to", Inward: "relates to"}
+	links := []*jira4claude.IssueLink{
+		{ID: "10001", Type: blocks, OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-2"}},
+		{ID: "10002", Type: relates, OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-2"}},
+		{ID: "10003", Type: blocks, InwardIssue: &jira4claude.LinkedIssue{Key: "TEST-2"}},
+		{ID: "10004", Type: blocks, OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-3"}},
+	}
+
+	getIssue := func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+		return &jira4claude.Issue{Key: key, Links: links}, nil
+	}
+
+	t.Run("deletes the only link between the issues", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-3"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"10004"}, deleted)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Unlinked TEST-1 and", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-3"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("reports several links as a conflict", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EConflict, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "TEST-1 blocks TEST-2 (10001), TEST-1 relates to TEST-2 (10002), TEST-1 is blocked by TEST-2 (10003)")
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("filters by type name", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2", Type: "Relates"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"10002"}, deleted)
+	})
+
+	t.Run("type name matches links in either direction", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2", Type: "Blocks"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EConflict, jira4claude.ErrorCode(err))
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("type name deletes links in both directions with all", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2", Type: "blocks", All: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		// "blocks" is also the outward description, but the name wins
+		assert.Equal(t, []string{"10001", "10003"}, deleted)
+	})
+
+	t.Run("filters by direction with a description", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2", Type: "is blocked by"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"10003"}, deleted)
+	})
+
+	t.Run("deletes every matching link with all", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-2", All: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"10001", "10002", "10003"}, deleted)
+		assert.Equal(t, "Deleted 3 links between TEST-1 and", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("deletes a link by ID", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.LinkDeleteCmd{ID: "10002"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, []string{"10002"}, deleted)
+		assert.Equal(t, "Deleted link 10002", printer.SuccessCalls[0].Msg)
+	})
+
+	t.Run("returns not found when no link matches", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted []string
+		ctx := &main.LinkContext{
+			Service: &mock.IssueService{
+				GetFn:       getIssue,
+				LinkTypesFn: linkTypes,
+				DeleteLinkFn: func(ctx context.Context, linkID string) error {
+					deleted = append(deleted, linkID)
+					return nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.LinkDeleteCmd{Key1: "TEST-1", Key2: "TEST-3", Type: "relates to"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `no link found between TEST-1 and TEST-3 matching type "relates to"`)
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("requires two keys or an ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.LinkContext{Service: &mock.IssueService{}, Printer: &mock.Printer{}}
+
+		for _, cmd := range []main.LinkDeleteCmd{{Key1: "TEST-1"}, {Key1: "TEST-1", ID: "10001"}} {
+			err := cmd.Run(ctx)
+
+			require.Error(t, err)
+			assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		}
+	})
+}
+
 // LinkListCmd tests
 
 func TestLinkListCmd(t *testing.T) {
@@ -55,6 +434,7 @@ func TestLinkListCmd(t *testing.T) {
 					},
 				}, nil
 			},
+			RemoteLinksFn: noRemoteLinks,
 		}
 
 		printer := &mock.Printer{}
@@ -93,6 +473,7 @@ func TestLinkListCmd(t *testing.T) {
 					Links:   []*jira4claude.IssueLink{},
 				}, nil
 			},
+			RemoteLinksFn: noRemoteLinks,
 		}
 
 		printer := &mock.Printer{}
@@ -135,4 +516,123 @@ func TestLinkListCmd(t *testing.T) {
 		assert.Contains(t, err.Error(), "Issue not found")
 		assert.Empty(t, printer.LinksCalls)
 	})
+
+	t.Run("lists web links after issue links", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{
+					Key: key,
+					Links: []*jira4claude.IssueLink{
+						{
+							Type:         jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+							OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-456", Status: "To Do", Type: "Task"},
+						},
+					},
+				}, nil
+			},
+			RemoteLinksFn: func(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+				return []*jira4claude.RemoteLink{{ID: "10000", URL: "https://github.com/org/repo/pull/42", Title: "PR #42"}}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{Service: svc, Printer: printer}
+
+		cmd := main.LinkListCmd{Key: "TEST-123"}
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, printer.LinksCalls, 1)
+		links := printer.LinksCalls[0].Links
+		require.Len(t, links, 2)
+		assert.Equal(t, "TEST-456", links[0].Key)
+		assert.Equal(t, "web link", links[1].Relationship)
+		assert.Equal(t, "https://github.com/org/repo/pull/42", links[1].URL)
+	})
+
+	t.Run("warns and lists issue links when web links fail to load", func(t *testing.T) {
+		t.Parallel()
+
+		svc := &mock.IssueService{
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{
+					Key: key,
+					Links: []*jira4claude.IssueLink{
+						{
+							Type:         jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+							OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-456", Status: "To Do", Type: "Task"},
+						},
+					},
+				}, nil
+			},
+			RemoteLinksFn: func(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+				return nil, &jira4claude.Error{Code: jira4claude.EForbidden, Message: "no permission"}
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{Service: svc, Printer: printer}
+
+		cmd := main.LinkListCmd{Key: "TEST-123"}
+		require.NoError(t, cmd.Run(ctx))
+
+		require.Len(t, printer.WarningCalls, 1)
+		assert.Contains(t, printer.WarningCalls[0], "failed to load web links: no permission")
+		require.Len(t, printer.LinksCalls, 1)
+		require.Len(t, printer.LinksCalls[0].Links, 1)
+		assert.Equal(t, "TEST-456", printer.LinksCalls[0].Links[0].Key)
+	})
+}
+
+func TestLinkWebCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds web link with title and relationship", func(t *testing.T) {
+		t.Parallel()
+
+		var capturedKey string
+		var captured *jira4claude.RemoteLink
+		svc := &mock.IssueService{
+			AddRemoteLinkFn: func(ctx context.Context, key string, link *jira4claude.RemoteLink) (*jira4claude.RemoteLink, error) {
+				capturedKey, captured = key, link
+				return &jira4claude.RemoteLink{ID: "10000", URL: link.URL, Title: link.Title, Relationship: link.Relationship}, nil
+			},
+		}
+
+		printer := &mock.Printer{}
+		ctx := &main.LinkContext{Service: svc, Printer: printer}
+
+		cmd := main.LinkWebCmd{
+			Key:          "TEST-1",
+			URL:          "https://github.com/org/repo/pull/42",
+			Title:        "PR #42",
+			Relationship: "pull request",
+		}
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST-1", capturedKey)
+		assert.Equal(t, &jira4claude.RemoteLink{
+			URL:          "https://github.com/org/repo/pull/42",
+			Title:        "PR #42",
+			Relationship: "pull request",
+		}, captured)
+		require.Len(t, printer.SuccessCalls, 1)
+		assert.Equal(t, "Linked https://github.com/org/repo/pull/42 to", printer.SuccessCalls[0].Msg)
+		assert.Equal(t, []string{"TEST-1"}, printer.SuccessCalls[0].Keys)
+	})
+
+	t.Run("rejects URL that is not absolute http", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.LinkContext{Service: &mock.IssueService{}, Printer: &mock.Printer{}}
+
+		for _, u := range []string{"github.com/org/repo", "ftp://example.com/file", "https://"} {
+			cmd := main.LinkWebCmd{Key: "TEST-1", URL: u}
+			err := cmd.Run(ctx)
+
+			require.Error(t, err, u)
+			assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		}
+	})
 }
@@ -2,13 +2,19 @@
 package main
 
 import (
+	"cmp"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/alecthomas/kong"
 	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/editor"
+	"github.com/fwojciec/jira4claude/git"
 	"github.com/fwojciec/jira4claude/http"
 	"github.com/fwojciec/jira4claude/json"
 	"github.com/fwojciec/jira4claude/markdown"
+	"github.com/fwojciec/jira4claude/template"
 	"github.com/fwojciec/jira4claude/yaml"
 )
 
@@ -22,13 +28,18 @@ var (
 
 // CLI defines the command structure for j4c.
 type CLI struct {
-	Config  string           `help:"Path to config file" type:"path"`
-	JSON    bool             `help:"Output in JSON format" short:"j"`
-	Version kong.VersionFlag `help:"Show version information"`
-
-	Issue IssueCmd `cmd:"" help:"Issue operations"`
-	Link  LinkCmd  `cmd:"" help:"Link operations"`
-	Init  InitCmd  `cmd:"" help:"Initialize config file"`
+	Config      string           `help:"Path to config file" type:"path"`
+	Profile     string           `help:"Named config profile to use" env:"J4C_PROFILE"`
+	JSON        bool             `help:"Output in JSON format" short:"j"`
+	PreserveADF bool             `help:"Embed unsupported ADF content as fenced adf blocks so edits round-trip losslessly" name:"preserve-adf"`
+	Version     kong.VersionFlag `help:"Show version information"`
+
+	Issue      IssueCmd   `cmd:"" help:"Issue operations"`
+	Link       LinkCmd    `cmd:"" help:"Link operations"`
+	VersionCmd VersionCmd `cmd:"" name:"version" help:"Project version operations"`
+	User       UserCmd    `cmd:"" help:"User operations"`
+	ConfigCmd  ConfigCmd  `cmd:"" name:"config" help:"Config operations"`
+	Init       InitCmd    `cmd:"" help:"Initialize config file"`
 }
 
 // IssueContext provides dependencies for issue commands.
@@ -36,6 +47,12 @@ type IssueContext struct {
 	Service   jira4claude.IssueService
 	Printer   jira4claude.Printer
 	Converter jira4claude.Converter
+	Templates jira4claude.TemplateService
+	Users     jira4claude.UserService
+	Workflows jira4claude.WorkflowService
+	Git       jira4claude.GitService
+	Editor    jira4claude.Editor
+	Stdin     io.Reader // Source for text flags given as "-"
 	Config    *jira4claude.Config
 }
 
@@ -46,6 +63,20 @@ type LinkContext struct {
 	Config  *jira4claude.Config
 }
 
+// VersionContext provides dependencies for version commands.
+type VersionContext struct {
+	Service jira4claude.VersionService
+	Printer jira4claude.Printer
+	Config  *jira4claude.Config
+}
+
+// UserContext provides dependencies for user commands.
+type UserContext struct {
+	Service jira4claude.UserService
+	Printer jira4claude.Printer
+	Config  *jira4claude.Config
+}
+
 // MessageContext provides dependencies for message-only commands.
 type MessageContext struct {
 	Printer jira4claude.MessagePrinter
@@ -53,8 +84,11 @@ type MessageContext struct {
 
 // ConfigContext provides dependencies for config commands.
 type ConfigContext struct {
-	Service jira4claude.ConfigService
-	Printer jira4claude.MessagePrinter
+	Service     jira4claude.ConfigService
+	Diagnostics jira4claude.DiagnosticService
+	Printer     jira4claude.Printer
+	Paths       []string // Config files, lowest precedence first; empty for init
+	Profile     string   // Profile selected by --profile or J4C_PROFILE
 }
 
 func main() {
@@ -67,16 +101,7 @@ func main() {
 	)
 
 	// Build printer (ServerURL set later after config is loaded)
-	var printer jira4claude.Printer
-	var jsonPrinter *json.Printer
-	var mdPrinter *markdown.Printer
-	if cli.JSON {
-		jsonPrinter = json.NewPrinterWithIO(os.Stdout, os.Stderr)
-		printer = jsonPrinter
-	} else {
-		mdPrinter = markdown.NewPrinterWithIO(os.Stdout, os.Stderr)
-		printer = mdPrinter
-	}
+	printer, setServerURL := newPrinter(cli.JSON)
 
 	// Init command doesn't need config
 	if ctx.Command() == "init" {
@@ -91,55 +116,127 @@ func main() {
 		return
 	}
 
+	// Config commands read the config file directly. Without any config file
+	// they still work on the environment overrides.
+	if strings.HasPrefix(ctx.Command(), "config ") {
+		paths, err := findConfig(cli.Config)
+		if err != nil && jira4claude.ErrorCode(err) != jira4claude.ENotFound {
+			printer.Error(err)
+			os.Exit(jira4claude.ExitCode(err))
+		}
+		configCtx := &ConfigContext{
+			Service:     yaml.NewService(),
+			Diagnostics: http.NewDiagnosticService(),
+			Printer:     printer,
+			Paths:       paths,
+			Profile:     cli.Profile,
+		}
+		if err := ctx.Run(configCtx); err != nil {
+			printer.Error(err)
+			os.Exit(jira4claude.ExitCode(err))
+		}
+		return
+	}
+
 	// Load config
-	cfg, err := loadConfig(cli.Config)
+	cfg, err := loadConfig(cli.Config, cli.Profile)
 	if err != nil {
 		printer.Error(err)
 		os.Exit(jira4claude.ExitCode(err))
 	}
 
-	// Set server URL on printers for URL output
-	if jsonPrinter != nil {
-		jsonPrinter.SetServerURL(cfg.Server)
-	}
-	if mdPrinter != nil {
-		mdPrinter.SetServerURL(cfg.Server)
+	// The profile may default to JSON output; --json always wins
+	if !cli.JSON && cfg.Output == jira4claude.OutputJSON {
+		printer, setServerURL = newPrinter(true)
 	}
 
+	// Set server URL on printers for URL output
+	setServerURL(cfg.Server)
+
 	// Build service
-	client, err := http.NewClient(cfg.Server)
+	var clientOpts []http.Option
+	if cfg.Netrc != "" {
+		clientOpts = append(clientOpts, http.WithNetrcPath(cfg.Netrc))
+	}
+	client, err := http.NewClient(cfg.Server, clientOpts...)
 	if err != nil {
 		printer.Error(err)
 		os.Exit(jira4claude.ExitCode(err))
 	}
 	svc := http.NewIssueService(client)
+	users := http.NewUserService(client)
 
 	// Build contexts
-	conv := markdown.New()
-	issueCtx := &IssueContext{Service: svc, Printer: printer, Converter: conv, Config: cfg}
+	convOpts := []markdown.Option{markdown.WithServer(cfg.Server)}
+	if cli.PreserveADF {
+		convOpts = append(convOpts, markdown.WithPreserveADF())
+	}
+	conv := markdown.New(convOpts...)
+	workDir, _ := os.Getwd()
+	configDir, _ := os.UserConfigDir()
+	issueCtx := &IssueContext{
+		Service:   svc,
+		Printer:   printer,
+		Converter: conv,
+		Users:     users,
+		Workflows: http.NewWorkflowService(client),
+		Templates: template.NewService(template.DiscoverDirs(workDir, configDir)...),
+		Git:       git.NewService(workDir),
+		Editor:    editor.New(cmp.Or(os.Getenv("VISUAL"), os.Getenv("EDITOR"))),
+		Stdin:     os.Stdin,
+		Config:    cfg,
+	}
 	linkCtx := &LinkContext{Service: svc, Printer: printer, Config: cfg}
+	versionCtx := &VersionContext{Service: http.NewVersionService(client), Printer: printer, Config: cfg}
+	userCtx := &UserContext{Service: users, Printer: printer, Config: cfg}
 
 	// Run command
-	if err := ctx.Run(issueCtx, linkCtx); err != nil {
+	if err := ctx.Run(issueCtx, linkCtx, versionCtx, userCtx); err != nil {
 		printer.Error(err)
 		os.Exit(jira4claude.ExitCode(err))
 	}
 }
 
-func loadConfig(configPath string) (*jira4claude.Config, error) {
-	if configPath == "" {
-		workDir, err := os.Getwd()
-		if err != nil {
-			return nil, err
-		}
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, err
-		}
-		configPath, err = yaml.DiscoverConfig(workDir, homeDir)
-		if err != nil {
-			return nil, err
-		}
+// newPrinter builds the JSON or markdown printer and returns it with its
+// SetServerURL method, since the server is only known after config is loaded.
+func newPrinter(useJSON bool) (jira4claude.Printer, func(string)) {
+	if useJSON {
+		p := json.NewPrinterWithIO(os.Stdout, os.Stderr)
+		return p, p.SetServerURL
+	}
+	p := markdown.NewPrinterWithIO(os.Stdout, os.Stderr)
+	return p, p.SetServerURL
+}
+
+// findConfig returns configPath if set, otherwise discovers the layered
+// config files for the working directory.
+func findConfig(configPath string) ([]string, error) {
+	if configPath != "" {
+		return []string{configPath}, nil
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.DiscoverConfig(workDir, homeDir)
+}
+
+// loadConfig loads the layered config with J4C_* environment overrides.
+// When no config file exists, the environment alone may supply the config;
+// if it is incomplete, the discovery error is returned since it explains
+// how to create a config file.
+func loadConfig(configPath, profile string) (*jira4claude.Config, error) {
+	paths, findErr := findConfig(configPath)
+	if findErr != nil && jira4claude.ErrorCode(findErr) != jira4claude.ENotFound {
+		return nil, findErr
+	}
+	cfg, err := yaml.NewService().Load(paths, profile)
+	if err != nil && findErr != nil {
+		return nil, findErr
 	}
-	return yaml.LoadConfig(configPath)
+	return cfg, err
 }
@@ -162,6 +162,28 @@ func TestIssueCommentCmd_RequiredFlags(t *testing.T) {
 		assert.Equal(t, "TEST-1", cli.Issue.Comment.Key)
 		assert.Equal(t, "A comment", cli.Issue.Comment.Body)
 	})
+	t.Run("succeeds with body-adf flag instead of body", func(t *testing.T) {
+		t.Parallel()
+
+		var cli main.CLI
+		parser, err := kong.New(&cli)
+		require.NoError(t, err)
+
+		_, err = parser.Parse([]string{"issue", "comment", "TEST-1", "--body-adf=body.json"})
+		require.NoError(t, err)
+		assert.Contains(t, cli.Issue.Comment.BodyADF, "body.json")
+	})
+
+	t.Run("fails with both body and body-adf flags", func(t *testing.T) {
+		t.Parallel()
+
+		var cli main.CLI
+		parser, err := kong.New(&cli)
+		require.NoError(t, err)
+
+		_, err = parser.Parse([]string{"issue", "comment", "TEST-1", "--body=A comment", "--body-adf=body.json"})
+		require.Error(t, err)
+	})
 }
 
 func TestIssueListCmd_DefaultLimit(t *testing.T) {
@@ -195,7 +217,7 @@ func TestIssueListCmd_DefaultLimit(t *testing.T) {
 func TestIssueCreateCmd_DefaultType(t *testing.T) {
 	t.Parallel()
 
-	t.Run("uses default type of Task", func(t *testing.T) {
+	t.Run("leaves type empty so config defaults can apply", func(t *testing.T) {
 		t.Parallel()
 
 		var cli main.CLI
@@ -204,7 +226,7 @@ func TestIssueCreateCmd_DefaultType(t *testing.T) {
 
 		_, err = parser.Parse([]string{"issue", "create", "--summary=Test"})
 		require.NoError(t, err)
-		assert.Equal(t, "Task", cli.Issue.Create.Type)
+		assert.Empty(t, cli.Issue.Create.Type)
 	})
 
 	t.Run("overrides default type", func(t *testing.T) {
@@ -220,6 +242,45 @@ func TestIssueCreateCmd_DefaultType(t *testing.T) {
 	})
 }
 
+func TestTextFlags_AcceptStdin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parses dash as description value", func(t *testing.T) {
+		t.Parallel()
+
+		var cli main.CLI
+		parser, err := kong.New(&cli)
+		require.NoError(t, err)
+
+		_, err = parser.Parse([]string{"issue", "create", "-s", "Test", "-d", "-"})
+		require.NoError(t, err)
+		assert.Equal(t, "-", cli.Issue.Create.Description)
+	})
+
+	t.Run("parses dash as comment body value", func(t *testing.T) {
+		t.Parallel()
+
+		var cli main.CLI
+		parser, err := kong.New(&cli)
+		require.NoError(t, err)
+
+		_, err = parser.Parse([]string{"issue", "comment", "TEST-1", "-b", "-"})
+		require.NoError(t, err)
+		assert.Equal(t, "-", cli.Issue.Comment.Body)
+	})
+
+	t.Run("rejects description with description file", func(t *testing.T) {
+		t.Parallel()
+
+		var cli main.CLI
+		parser, err := kong.New(&cli)
+		require.NoError(t, err)
+
+		_, err = parser.Parse([]string{"issue", "create", "-s", "Test", "-d", "text", "--description-file", "desc.md"})
+		require.Error(t, err)
+	})
+}
+
 // Error propagation tests
 
 func TestIssueViewCmd_ReturnsServiceError(t *testing.T) {
@@ -310,6 +371,7 @@ func TestLinkCreateCmd_ReturnsServiceError(t *testing.T) {
 		t.Parallel()
 
 		svc := &mock.IssueService{
+			LinkTypesFn: linkTypes,
 			LinkFn: func(ctx context.Context, inward, linkType, outward string) error {
 				return errors.New("link failed")
 			},
@@ -340,7 +402,12 @@ func TestLinkDeleteCmd_ReturnsServiceError(t *testing.T) {
 		t.Parallel()
 
 		svc := &mock.IssueService{
-			UnlinkFn: func(ctx context.Context, key1, key2 string) error {
+			GetFn: func(ctx context.Context, key string) (*jira4claude.Issue, error) {
+				return &jira4claude.Issue{Key: key, Links: []*jira4claude.IssueLink{
+					{ID: "10001", OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-2"}},
+				}}, nil
+			},
+			DeleteLinkFn: func(ctx context.Context, linkID string) error {
 				return errors.New("unlink failed")
 			},
 		}
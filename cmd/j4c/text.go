@@ -0,0 +1,69 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// stdinArg is the flag value that reads text from standard input.
+const stdinArg = "-"
+
+// readText resolves a markdown text flag. A value of "-" reads stdin, a
+// non-empty file reads that file, and otherwise value is returned as-is.
+func readText(stdin io.Reader, value, file string) (string, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", &jira4claude.Error{
+				Code:    jira4claude.EValidation,
+				Message: "failed to read file " + file,
+				Inner:   err,
+			}
+		}
+		return string(data), nil
+	}
+	if value != stdinArg {
+		return value, nil
+	}
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to read stdin",
+			Inner:   err,
+		}
+	}
+	return string(data), nil
+}
+
+// editText opens text in the editor. An empty result aborts the command with
+// a validation error, like an empty git commit message.
+func editText(editor jira4claude.Editor, text string) (string, error) {
+	edited, err := editor.Edit(text)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(edited) == "" {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "aborting: edited text is empty",
+		}
+	}
+	return edited, nil
+}
+
+// maxSummaryLength is the longest summary Jira accepts, in characters.
+const maxSummaryLength = 255
+
+// truncateSummary shortens summary to maxSummaryLength characters, ending
+// it with an ellipsis, and reports whether it had to.
+func truncateSummary(summary string) (string, bool) {
+	runes := []rune(summary)
+	if len(runes) <= maxSummaryLength {
+		return summary, false
+	}
+	return strings.TrimSpace(string(runes[:maxSummaryLength-1])) + "…", true
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// accountIDPattern matches Jira Cloud account IDs, either 24 hex digits or
+// a numeric prefix and a UUID (e.g., "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077").
+//
+//nolint:gochecknoglobals // Compiled regex is immutable
+var accountIDPattern = regexp.MustCompile(`^([0-9a-f]{24}|[0-9]+:[0-9a-f]{8}(-[0-9a-f]{4}){3}-[0-9a-f]{12})$`)
+
+// UserCmd groups user subcommands.
+type UserCmd struct {
+	Search UserSearchCmd `cmd:"" help:"Search users by name or email"`
+}
+
+// UserSearchCmd searches for users.
+type UserSearchCmd struct {
+	Query string `arg:"" help:"Display name or email to search for"`
+	Issue string `help:"Only list users who can be assigned this issue" short:"i"`
+}
+
+// Run executes the user search command.
+func (c *UserSearchCmd) Run(ctx *UserContext) error {
+	users, err := searchUsers(context.Background(), ctx.Service, c.Issue, c.Query)
+	if err != nil {
+		return err
+	}
+	ctx.Printer.Users(users)
+	return nil
+}
+
+// resolveUser finds the single user that query refers to: "me" for the
+// authenticated user, an account ID, an email or a display name. When key is
+// set, only users assignable to that issue are considered. Exact email or
+// display name matches win over partial ones; several matches are reported
+// as a conflict listing the candidates.
+func resolveUser(ctx context.Context, users jira4claude.UserService, key, query string) (*jira4claude.User, error) {
+	if strings.EqualFold(query, jira4claude.UserMe) {
+		return users.Me(ctx)
+	}
+	if accountIDPattern.MatchString(query) {
+		return &jira4claude.User{AccountID: query}, nil
+	}
+
+	candidates, err := searchUsers(ctx, users, key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var exact []*jira4claude.User
+	for _, u := range candidates {
+		if strings.EqualFold(u.Email, query) || strings.EqualFold(u.DisplayName, query) {
+			exact = append(exact, u)
+		}
+	}
+	if len(exact) > 0 {
+		candidates = exact
+	}
+
+	switch len(candidates) {
+	case 0:
+		msg := "no user matches " + strconv.Quote(query)
+		if key != "" {
+			msg = "no user assignable to " + key + " matches " + strconv.Quote(query)
+		}
+		return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+	case 1:
+		return candidates[0], nil
+	}
+
+	names := make([]string, len(candidates))
+	for i, u := range candidates {
+		names[i] = u.DisplayName
+		if u.Email != "" {
+			names[i] += " <" + u.Email + ">"
+		}
+	}
+	return nil, &jira4claude.Error{
+		Code:    jira4claude.EConflict,
+		Message: strconv.Quote(query) + " matches several users: " + strings.Join(names, ", ") + "; use an email or account ID",
+	}
+}
+
+// userLabel names a user for messages, falling back to the account ID when
+// the display name is unknown.
+func userLabel(user *jira4claude.User) string {
+	return cmp.Or(user.DisplayName, user.AccountID)
+}
+
+// searchUsers searches all users, or only those assignable to key when set.
+func searchUsers(ctx context.Context, users jira4claude.UserService, key, query string) ([]*jira4claude.User, error) {
+	if key != "" {
+		return users.Assignable(ctx, key, query)
+	}
+	return users.Search(ctx, query)
+}
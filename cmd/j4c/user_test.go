@@ -0,0 +1,141 @@
+package main_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	main "github.com/fwojciec/jira4claude/cmd/j4c"
+	"github.com/fwojciec/jira4claude/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSearchCmd(t *testing.T) {
+	t.Parallel()
+
+	users := []*jira4claude.User{{AccountID: "acc-1", DisplayName: "Jane Doe"}}
+
+	t.Run("searches all users", func(t *testing.T) {
+		t.Parallel()
+
+		var query string
+		printer := &mock.Printer{}
+		ctx := &main.UserContext{
+			Service: &mock.UserService{
+				SearchFn: func(ctx context.Context, q string) ([]*jira4claude.User, error) {
+					query = q
+					return users, nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.UserSearchCmd{Query: "jane"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "jane", query)
+		require.Len(t, printer.UsersCalls, 1)
+		assert.Equal(t, users, printer.UsersCalls[0])
+	})
+
+	t.Run("searches users assignable to an issue", func(t *testing.T) {
+		t.Parallel()
+
+		var issueKey string
+		printer := &mock.Printer{}
+		ctx := &main.UserContext{
+			Service: &mock.UserService{
+				AssignableFn: func(ctx context.Context, key, q string) ([]*jira4claude.User, error) {
+					issueKey = key
+					return users, nil
+				},
+			},
+			Printer: printer,
+		}
+		cmd := main.UserSearchCmd{Query: "jane", Issue: "TEST-1"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST-1", issueKey)
+		require.Len(t, printer.UsersCalls, 1)
+	})
+}
+
+func TestIssueAssignCmd_UserResolution(t *testing.T) {
+	t.Parallel()
+
+	assign := func(t *testing.T, query string, candidates []*jira4claude.User) (string, error) {
+		t.Helper()
+		var assigned string
+		ctx := &main.IssueContext{
+			Service: &mock.IssueService{
+				AssignFn: func(ctx context.Context, key, accountID string) error {
+					assigned = accountID
+					return nil
+				},
+			},
+			Users: &mock.UserService{
+				AssignableFn: func(ctx context.Context, key, q string) ([]*jira4claude.User, error) {
+					return candidates, nil
+				},
+			},
+			Printer: &mock.Printer{},
+		}
+		cmd := main.IssueAssignCmd{Key: "TEST-1", Assignee: query}
+		return assigned, cmd.Run(ctx)
+	}
+
+	janes := []*jira4claude.User{
+		{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+		{AccountID: "acc-2", DisplayName: "Jane Smith", Email: "jsmith@example.com"},
+	}
+
+	t.Run("prefers an exact display name match", func(t *testing.T) {
+		t.Parallel()
+
+		assigned, err := assign(t, "jane smith", janes)
+
+		require.NoError(t, err)
+		assert.Equal(t, "acc-2", assigned)
+	})
+
+	t.Run("uses the only partial match", func(t *testing.T) {
+		t.Parallel()
+
+		assigned, err := assign(t, "smi", janes[1:])
+
+		require.NoError(t, err)
+		assert.Equal(t, "acc-2", assigned)
+	})
+
+	t.Run("returns conflict listing ambiguous matches", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := assign(t, "jane", janes)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EConflict, jira4claude.ErrorCode(err))
+		assert.Contains(t, jira4claude.ErrorMessage(err), "Jane Doe <jane@example.com>")
+		assert.Contains(t, jira4claude.ErrorMessage(err), "Jane Smith <jsmith@example.com>")
+	})
+
+	t.Run("returns not found without matches", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := assign(t, "nobody", nil)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, jira4claude.ErrorMessage(err), "TEST-1")
+	})
+
+	t.Run("passes account IDs through without a lookup", func(t *testing.T) {
+		t.Parallel()
+
+		assigned, err := assign(t, "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077", assigned)
+	})
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// dateLayout is the Jira date format used for release dates.
+const dateLayout = "2006-01-02"
+
+// VersionCmd groups version subcommands.
+type VersionCmd struct {
+	List    VersionListCmd    `cmd:"" help:"List project versions"`
+	Create  VersionCreateCmd  `cmd:"" help:"Create a project version"`
+	Release VersionReleaseCmd `cmd:"" help:"Mark a version as released"`
+}
+
+// VersionListCmd lists the versions of a project.
+type VersionListCmd struct {
+	Project string `help:"Project key" short:"p"`
+	All     bool   `help:"Include archived versions"`
+}
+
+// Run executes the version list command.
+func (c *VersionListCmd) Run(ctx *VersionContext) error {
+	versions, err := ctx.Service.List(context.Background(), cmp.Or(c.Project, ctx.Config.Project))
+	if err != nil {
+		return err
+	}
+
+	if !c.All {
+		shown := make([]*jira4claude.Version, 0, len(versions))
+		for _, v := range versions {
+			if !v.Archived {
+				shown = append(shown, v)
+			}
+		}
+		versions = shown
+	}
+	ctx.Printer.Versions(versions)
+	return nil
+}
+
+// VersionCreateCmd creates a project version.
+type VersionCreateCmd struct {
+	Name        string `arg:"" help:"Version name (e.g., 1.2.0)"`
+	Project     string `help:"Project key" short:"p"`
+	Description string `help:"Version description" short:"d"`
+	ReleaseDate string `help:"Planned release date (YYYY-MM-DD)" name:"release-date"`
+	Released    bool   `help:"Create the version as already released"`
+}
+
+// Run executes the version create command.
+func (c *VersionCreateCmd) Run(ctx *VersionContext) error {
+	if err := validateDate(c.ReleaseDate); err != nil {
+		return err
+	}
+
+	version := &jira4claude.Version{
+		Project:     cmp.Or(c.Project, ctx.Config.Project),
+		Name:        c.Name,
+		Description: c.Description,
+		Released:    c.Released,
+		ReleaseDate: c.ReleaseDate,
+	}
+	created, err := ctx.Service.Create(context.Background(), version)
+	if err != nil {
+		return err
+	}
+
+	ctx.Printer.Success("Created version:", created.Name)
+	return nil
+}
+
+// VersionReleaseCmd marks a project version as released.
+type VersionReleaseCmd struct {
+	Name    string `arg:"" help:"Version name"`
+	Project string `help:"Project key" short:"p"`
+	Date    string `help:"Release date (YYYY-MM-DD, default: today)"`
+}
+
+// Run executes the version release command.
+func (c *VersionReleaseCmd) Run(ctx *VersionContext) error {
+	if err := validateDate(c.Date); err != nil {
+		return err
+	}
+
+	date := cmp.Or(c.Date, time.Now().Format(dateLayout))
+	released, err := ctx.Service.Release(context.Background(), cmp.Or(c.Project, ctx.Config.Project), c.Name, date)
+	if err != nil {
+		return err
+	}
+
+	ctx.Printer.Success("Released version:", released.Name)
+	return nil
+}
+
+// validateDate checks that a non-empty date is in YYYY-MM-DD format.
+func validateDate(date string) error {
+	if date == "" {
+		return nil
+	}
+	if _, err := time.Parse(dateLayout, date); err != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "invalid date " + date + "; use YYYY-MM-DD",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package main_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fwojciec/jira4claude"
+	main "github.com/fwojciec/jira4claude/cmd/j4c"
+	"github.com/fwojciec/jira4claude/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionListCmd(t *testing.T) {
+	t.Parallel()
+
+	versions := []*jira4claude.Version{
+		{Name: "1.0", Released: true, Archived: true},
+		{Name: "1.1"},
+	}
+
+	t.Run("hides archived versions using config project", func(t *testing.T) {
+		t.Parallel()
+
+		var project string
+		printer := &mock.Printer{}
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{
+				ListFn: func(ctx context.Context, p string) ([]*jira4claude.Version, error) {
+					project = p
+					return versions, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionListCmd{}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "TEST", project)
+		require.Len(t, printer.VersionsCalls, 1)
+		require.Len(t, printer.VersionsCalls[0], 1)
+		assert.Equal(t, "1.1", printer.VersionsCalls[0][0].Name)
+	})
+
+	t.Run("all includes archived versions", func(t *testing.T) {
+		t.Parallel()
+
+		var project string
+		printer := &mock.Printer{}
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{
+				ListFn: func(ctx context.Context, p string) ([]*jira4claude.Version, error) {
+					project = p
+					return versions, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionListCmd{Project: "OTHER", All: true}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "OTHER", project)
+		require.Len(t, printer.VersionsCalls, 1)
+		assert.Len(t, printer.VersionsCalls[0], 2)
+	})
+}
+
+func TestVersionCreateCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates version in config project", func(t *testing.T) {
+		t.Parallel()
+
+		var captured *jira4claude.Version
+		printer := &mock.Printer{}
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{
+				CreateFn: func(ctx context.Context, version *jira4claude.Version) (*jira4claude.Version, error) {
+					captured = version
+					return &jira4claude.Version{ID: "10", Name: version.Name}, nil
+				},
+			},
+			Printer: printer,
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionCreateCmd{Name: "1.2", Description: "Spring", ReleaseDate: "2024-06-01"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, &jira4claude.Version{
+			Project: "TEST", Name: "1.2", Description: "Spring", ReleaseDate: "2024-06-01",
+		}, captured)
+		require.Len(t, printer.SuccessCalls, 1)
+	})
+
+	t.Run("rejects malformed release date", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionCreateCmd{Name: "1.2", ReleaseDate: "June 1"}
+
+		err := cmd.Run(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestVersionReleaseCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults release date to today", func(t *testing.T) {
+		t.Parallel()
+
+		var date string
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{
+				ReleaseFn: func(ctx context.Context, project, name, d string) (*jira4claude.Version, error) {
+					date = d
+					return &jira4claude.Version{Name: name, Released: true, ReleaseDate: d}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionReleaseCmd{Name: "1.2"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, time.Now().Format("2006-01-02"), date)
+	})
+
+	t.Run("uses explicit date", func(t *testing.T) {
+		t.Parallel()
+
+		var date string
+		ctx := &main.VersionContext{
+			Service: &mock.VersionService{
+				ReleaseFn: func(ctx context.Context, project, name, d string) (*jira4claude.Version, error) {
+					date = d
+					return &jira4claude.Version{Name: name, Released: true, ReleaseDate: d}, nil
+				},
+			},
+			Printer: &mock.Printer{},
+			Config:  &jira4claude.Config{Project: "TEST"},
+		}
+		cmd := main.VersionReleaseCmd{Name: "1.2", Date: "2024-07-01"}
+
+		require.NoError(t, cmd.Run(ctx))
+
+		assert.Equal(t, "2024-07-01", date)
+	})
+}
@@ -1,5 +1,11 @@
 package jira4claude
 
+// Output format names for Config.Output.
+const (
+	OutputMarkdown = "markdown"
+	OutputJSON     = "json"
+)
+
 // Config holds the application configuration.
 type Config struct {
 	// Server is the Jira server URL (e.g., "https://example.atlassian.net").
@@ -7,4 +13,49 @@ type Config struct {
 
 	// Project is the default Jira project key (e.g., "J4C").
 	Project string
+
+	// Profile is the name of the selected profile, or empty if none is selected.
+	Profile string
+
+	// Netrc is the path to the netrc file holding credentials for Server.
+	// Empty means the default ~/.netrc.
+	Netrc string
+
+	// Output is the default output format (OutputMarkdown or OutputJSON).
+	// Empty means markdown. The --json flag always takes precedence.
+	Output string
+
+	// Create holds defaults applied when creating issues.
+	Create CreateDefaults
+}
+
+// CreateDefaults holds default field values for new issues.
+// Flags given to issue create take precedence.
+type CreateDefaults struct {
+	Type       string
+	Labels     []string
+	Priority   string
+	Components []string
+	Assignee   string            // Assignee: "me", an email, a name or an account ID
+	Watchers   []string          // Users added as watchers: "me", an email, a name or an account ID
+	Templates  map[string]string // Markdown description templates keyed by issue type
+}
+
+// Profile is a named configuration for a Jira site and project.
+// Empty fields inherit the top-level values of the config file.
+type Profile struct {
+	Name    string
+	Server  string
+	Project string
+	Netrc   string
+	Output  string
+	Default bool // True if this is the config file's default profile
+	Active  bool // True if this profile is selected for the current invocation
+}
+
+// ConfigSetting is a single effective config value and where it came from.
+type ConfigSetting struct {
+	Key    string // Config key (e.g., "server")
+	Value  string
+	Origin string // Config file the value was read from
 }
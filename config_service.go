@@ -1,5 +1,7 @@
 package jira4claude
 
+import "context"
+
 // InitResult contains the result of the Init operation.
 type InitResult struct {
 	ConfigCreated   bool
@@ -11,4 +13,44 @@ type InitResult struct {
 type ConfigService interface {
 	// Init creates a new config file in the given directory.
 	Init(dir, server, project string) (*InitResult, error)
+
+	// Profiles returns the named profiles defined in the config files at paths,
+	// sorted by name. Paths are ordered from lowest to highest precedence.
+	Profiles(paths []string) ([]*Profile, error)
+
+	// Show returns the effective settings merged from the config files at paths
+	// for the given profile (empty for the default), each with its origin.
+	Show(paths []string, profile string) ([]*ConfigSetting, error)
+
+	// Load returns the config merged from the config files at paths.
+	Load(paths []string, profile string) (*Config, error)
+
+	// Get returns the effective value of a single key (e.g., "server" or
+	// "profiles.web.project"), or ENotFound if it is not set.
+	Get(paths []string, profile, key string) (*ConfigSetting, error)
+
+	// Set sets key to value in the config file at path, creating the file if needed.
+	Set(path, key, value string) error
+
+	// Unset removes key from the config file at path.
+	Unset(path, key string) error
+
+	// Validate checks the config files at paths for unknown keys and invalid
+	// values. It returns an EValidation error listing every problem found.
+	Validate(paths []string, profile string) error
+}
+
+// Check is the result of a single diagnostic check.
+type Check struct {
+	Name    string // Short check name (e.g., "auth")
+	Code    string // Error code when the check failed; empty when it passed
+	Message string
+	Skipped bool // True if the check did not run because an earlier check failed
+}
+
+// DiagnosticService checks that a config can be used to talk to Jira.
+type DiagnosticService interface {
+	// Diagnose runs the credential, server reachability, authentication and
+	// project checks in order. Checks after the first failure are skipped.
+	Diagnose(ctx context.Context, cfg *Config) []*Check
 }
@@ -0,0 +1,7 @@
+package jira4claude
+
+// Editor lets the user edit text interactively, e.g. in $EDITOR.
+type Editor interface {
+	// Edit opens text for editing and returns the edited text.
+	Edit(text string) (string, error)
+}
@@ -0,0 +1,69 @@
+// Package editor edits text in the user's external editor.
+package editor
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.Editor = (*Editor)(nil)
+
+// Editor implements jira4claude.Editor by running an editor command on a
+// temporary markdown file.
+type Editor struct {
+	command string
+}
+
+// New creates an Editor that runs command, e.g. "vim" or "code --wait".
+// The command is run by the shell with the file path appended, so it may
+// include arguments.
+func New(command string) *Editor {
+	return &Editor{command: command}
+}
+
+// Edit writes text to a temporary file, opens it in the editor attached to
+// the terminal, and returns the file contents once the editor exits.
+func (e *Editor) Edit(text string) (string, error) {
+	if e.command == "" {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "no editor configured; set $VISUAL or $EDITOR",
+		}
+	}
+
+	f, err := os.CreateTemp("", "j4c-*.md")
+	if err != nil {
+		return "", &jira4claude.Error{Code: jira4claude.EInternal, Message: "failed to create temp file", Inner: err}
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	_, err = f.WriteString(text)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", &jira4claude.Error{Code: jira4claude.EInternal, Message: "failed to write temp file", Inner: err}
+	}
+
+	cmd := exec.Command("sh", "-c", e.command+` "$1"`, "j4c", path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "editor " + e.command + " failed",
+			Inner:   err,
+		}
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", &jira4claude.Error{Code: jira4claude.EInternal, Message: "failed to read edited file", Inner: err}
+	}
+	return string(edited), nil
+}
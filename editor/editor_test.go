@@ -0,0 +1,46 @@
+package editor_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/editor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditor_Edit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns text edited by the command", func(t *testing.T) {
+		t.Parallel()
+
+		// Upper-cases the file in place, so the output depends on the input
+		ed := editor.New(`f() { tr a-z A-Z < "$1" > "$1.tmp" && mv "$1.tmp" "$1"; }; f`)
+
+		got, err := ed.Edit("draft description\n")
+
+		require.NoError(t, err)
+		assert.Equal(t, "DRAFT DESCRIPTION\n", got)
+	})
+
+	t.Run("returns error when the editor fails", func(t *testing.T) {
+		t.Parallel()
+
+		ed := editor.New("false")
+
+		_, err := ed.Edit("text")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EInternal, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns validation error without an editor command", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := editor.New("").Edit("text")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
@@ -0,0 +1,84 @@
+// Package git reads the state of the local git checkout by running the git
+// command.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.GitService = (*Service)(nil)
+
+// Service implements jira4claude.GitService using the git command.
+type Service struct {
+	dir string
+}
+
+// NewService creates a new Service for the checkout containing dir.
+func NewService(dir string) *Service {
+	return &Service{dir: dir}
+}
+
+// Head returns the current branch and commit. The branch is empty when HEAD
+// is detached. Returns ENotFound when dir is not in a git repository or the
+// repository has no commits yet.
+func (s *Service) Head(ctx context.Context) (*jira4claude.GitHead, error) {
+	commit, err := s.run(ctx, "rev-parse", "--verify", "-q", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	// symbolic-ref exits non-zero on a detached HEAD; that is not an error here
+	branch, _ := s.run(ctx, "symbolic-ref", "-q", "--short", "HEAD")
+	return &jira4claude.GitHead{Branch: branch, Commit: commit}, nil
+}
+
+// SearchDirs returns the directories from the repository root down to workDir,
+// or just workDir when it is not inside a repository. The repository root is
+// the nearest ancestor with a .git directory, so worktrees nested in a
+// repository, whose .git is a file, search up to the main repository.
+func SearchDirs(workDir string) []string {
+	workDir = filepath.Clean(workDir)
+	var dirs []string
+	for dir := workDir; ; {
+		dirs = append(dirs, dir)
+		if info, err := os.Stat(filepath.Join(dir, ".git")); err == nil && info.IsDir() {
+			slices.Reverse(dirs)
+			return dirs
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return []string{workDir}
+		}
+		dir = parent
+	}
+}
+
+// run runs git with args in the service directory and returns its trimmed output.
+func (s *Service) run(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = s.dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			msg := strings.TrimSpace(stderr.String())
+			if msg == "" {
+				msg = "no commit checked out"
+			}
+			return "", &jira4claude.Error{Code: jira4claude.ENotFound, Message: "git: " + msg, Inner: err}
+		}
+		return "", &jira4claude.Error{Code: jira4claude.EInternal, Message: "failed to run git", Inner: err}
+	}
+	return strings.TrimSpace(string(out)), nil
+}
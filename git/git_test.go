@@ -0,0 +1,128 @@
+package git_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gitRun runs git with args in dir and returns its trimmed output.
+func gitRun(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(cmd.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+	)
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	return strings.TrimSpace(string(out))
+}
+
+// newRepo creates a repository with one commit on branch main.
+func newRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	gitRun(t, dir, "init", "-q", "-b", "main")
+	gitRun(t, dir, "commit", "-q", "--allow-empty", "-m", "initial")
+	return dir
+}
+
+func TestService_Head(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns branch and commit", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newRepo(t)
+		commit := gitRun(t, dir, "rev-parse", "HEAD")
+
+		head, err := git.NewService(dir).Head(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, "main", head.Branch)
+		assert.Equal(t, commit, head.Commit)
+	})
+
+	t.Run("returns empty branch when HEAD is detached", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newRepo(t)
+		commit := gitRun(t, dir, "rev-parse", "HEAD")
+		gitRun(t, dir, "checkout", "-q", "--detach")
+
+		head, err := git.NewService(dir).Head(context.Background())
+
+		require.NoError(t, err)
+		assert.Empty(t, head.Branch)
+		assert.Equal(t, commit, head.Commit)
+	})
+
+	t.Run("returns not found outside a repository", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := exec.LookPath("git"); err != nil {
+			t.Skip("git not installed")
+		}
+
+		_, err := git.NewService(t.TempDir()).Head(context.Background())
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestSearchDirs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns dirs from repository root down to work dir", func(t *testing.T) {
+		t.Parallel()
+
+		repo := t.TempDir()
+		workDir := filepath.Join(repo, "a", "b")
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0o755))
+		require.NoError(t, os.MkdirAll(workDir, 0o755))
+
+		dirs := git.SearchDirs(workDir)
+
+		assert.Equal(t, []string{repo, filepath.Join(repo, "a"), workDir}, dirs)
+	})
+
+	t.Run("searches past a nested worktree up to the main repository", func(t *testing.T) {
+		t.Parallel()
+
+		repo := t.TempDir()
+		worktree := filepath.Join(repo, "wt")
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0o755))
+		require.NoError(t, os.MkdirAll(worktree, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: ../.git/worktrees/wt\n"), 0o644))
+
+		dirs := git.SearchDirs(worktree)
+
+		assert.Equal(t, []string{repo, worktree}, dirs)
+	})
+
+	t.Run("returns only work dir outside a repository", func(t *testing.T) {
+		t.Parallel()
+
+		workDir := t.TempDir()
+
+		dirs := git.SearchDirs(workDir)
+
+		assert.Equal(t, []string{workDir}, dirs)
+	})
+}
@@ -247,6 +247,32 @@ func (c *Client) DoRequest(req *http.Request, expectedStatus int) ([]byte, error
 	return nil, lastErr
 }
 
+// getJSON issues a GET request and decodes a 200 response into v.
+func getJSON(ctx context.Context, client *Client, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to create request",
+			Inner:   err,
+		}
+	}
+
+	respBody, err := client.DoRequest(req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(respBody, v); err != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to parse response",
+			Inner:   err,
+		}
+	}
+	return nil
+}
+
 // doRequestOnce executes a single HTTP request attempt.
 func (c *Client) doRequestOnce(req *http.Request) ([]byte, int, error) {
 	resp, err := c.Do(req)
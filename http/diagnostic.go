@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Diagnostic check names, in the order they run.
+const (
+	CheckCredentials = "credentials"
+	CheckServer      = "server"
+	CheckAuth        = "auth"
+	CheckProject     = "project"
+)
+
+// DiagnosticService implements jira4claude.DiagnosticService using the Jira REST API.
+type DiagnosticService struct {
+	opts []Option
+}
+
+// Compile-time interface verification.
+var _ jira4claude.DiagnosticService = (*DiagnosticService)(nil)
+
+// NewDiagnosticService creates a new DiagnosticService.
+// The options are applied to the client built for each diagnosis;
+// retries are disabled so an unreachable server fails fast.
+func NewDiagnosticService(opts ...Option) *DiagnosticService {
+	return &DiagnosticService{opts: opts}
+}
+
+// serverInfoResponse represents the /serverInfo API response.
+type serverInfoResponse struct {
+	Version string `json:"version"`
+}
+
+// projectResponse represents the /project/{key} API response.
+type projectResponse struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// Diagnose runs the credential, server, auth and project checks for cfg.
+func (s *DiagnosticService) Diagnose(ctx context.Context, cfg *jira4claude.Config) []*jira4claude.Check {
+	checks := make([]*jira4claude.Check, 0, 4)
+	skipRest := func(names ...string) []*jira4claude.Check {
+		for _, name := range names {
+			checks = append(checks, &jira4claude.Check{Name: name, Skipped: true, Message: "skipped"})
+		}
+		return checks
+	}
+
+	opts := append([]Option{WithMaxRetries(0)}, s.opts...)
+	if cfg.Netrc != "" {
+		opts = append(opts, WithNetrcPath(cfg.Netrc))
+	}
+	client, err := NewClient(cfg.Server, opts...)
+	if err != nil {
+		checks = append(checks, failedCheck(CheckCredentials, err))
+		return skipRest(CheckServer, CheckAuth, CheckProject)
+	}
+	checks = append(checks, &jira4claude.Check{Name: CheckCredentials, Message: "netrc entry for " + client.baseURL.Host})
+
+	// Reachability: any HTTP response means the server answered
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/rest/api/3/serverInfo", nil)
+	if err != nil {
+		checks = append(checks, failedCheck(CheckServer, err))
+		return skipRest(CheckAuth, CheckProject)
+	}
+	body, status, err := client.doRequestOnce(req)
+	if err != nil {
+		checks = append(checks, &jira4claude.Check{
+			Name:    CheckServer,
+			Code:    jira4claude.EInternal,
+			Message: cfg.Server + " is unreachable: " + err.Error(),
+		})
+		return skipRest(CheckAuth, CheckProject)
+	}
+	if status == http.StatusNotFound {
+		checks = append(checks, &jira4claude.Check{
+			Name:    CheckServer,
+			Code:    jira4claude.ENotFound,
+			Message: cfg.Server + " does not look like a Jira server",
+		})
+		return skipRest(CheckAuth, CheckProject)
+	}
+	serverMsg := cfg.Server + " is reachable"
+	var info serverInfoResponse
+	if status == http.StatusOK && json.Unmarshal(body, &info) == nil && info.Version != "" {
+		serverMsg += " (Jira " + info.Version + ")"
+	}
+	checks = append(checks, &jira4claude.Check{Name: CheckServer, Message: serverMsg})
+
+	var me userResponse
+	if err := getJSON(ctx, client, "/rest/api/3/myself", &me); err != nil {
+		checks = append(checks, failedCheck(CheckAuth, err))
+		return skipRest(CheckProject)
+	}
+	checks = append(checks, &jira4claude.Check{Name: CheckAuth, Message: "authenticated as " + me.DisplayName})
+
+	var project projectResponse
+	if err := getJSON(ctx, client, "/rest/api/3/project/"+url.PathEscape(cfg.Project), &project); err != nil {
+		checks = append(checks, failedCheck(CheckProject, err))
+		return checks
+	}
+	checks = append(checks, &jira4claude.Check{Name: CheckProject, Message: project.Key + " (" + project.Name + ")"})
+
+	return checks
+}
+
+// failedCheck builds a failed check from an error.
+func failedCheck(name string, err error) *jira4claude.Check {
+	return &jira4claude.Check{
+		Name:    name,
+		Code:    jira4claude.ErrorCode(err),
+		Message: jira4claude.ErrorMessage(err),
+	}
+}
@@ -0,0 +1,144 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	jirahttp "github.com/fwojciec/jira4claude/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticService_Diagnose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes all checks for a healthy config", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/rest/api/3/serverInfo":
+				_, _ = w.Write([]byte(`{"version": "1001.0.0"}`))
+			case "/rest/api/3/myself":
+				_, _ = w.Write([]byte(`{"accountId": "abc", "displayName": "Jane Doe"}`))
+			case "/rest/api/3/project/TEST":
+				_, _ = w.Write([]byte(`{"key": "TEST", "name": "Test Project"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		cfg := &jira4claude.Config{Server: server.URL, Project: "TEST", Netrc: writeNetrc(t, server.URL)}
+		checks := jirahttp.NewDiagnosticService().Diagnose(context.Background(), cfg)
+
+		require.Len(t, checks, 4)
+		for _, check := range checks {
+			assert.Empty(t, check.Code, check.Name)
+			assert.False(t, check.Skipped, check.Name)
+		}
+		assert.Equal(t, jirahttp.CheckServer, checks[1].Name)
+		assert.Contains(t, checks[1].Message, "Jira 1001.0.0")
+		assert.Equal(t, "authenticated as Jane Doe", checks[2].Message)
+		assert.Equal(t, "TEST (Test Project)", checks[3].Message)
+	})
+
+	t.Run("fails credentials check and skips the rest without netrc entry", func(t *testing.T) {
+		t.Parallel()
+
+		netrcPath := filepath.Join(t.TempDir(), "netrc")
+		require.NoError(t, os.WriteFile(netrcPath, []byte("machine other.example.com\n  login x\n  password y\n"), 0o600))
+
+		cfg := &jira4claude.Config{Server: "https://example.atlassian.net", Project: "TEST", Netrc: netrcPath}
+		checks := jirahttp.NewDiagnosticService().Diagnose(context.Background(), cfg)
+
+		require.Len(t, checks, 4)
+		assert.Equal(t, jirahttp.CheckCredentials, checks[0].Name)
+		assert.Equal(t, jira4claude.EUnauthorized, checks[0].Code)
+		assert.True(t, checks[1].Skipped)
+		assert.True(t, checks[2].Skipped)
+		assert.True(t, checks[3].Skipped)
+	})
+
+	t.Run("fails server check when server is unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		serverURL := server.URL
+		netrcPath := writeNetrc(t, serverURL)
+		server.Close()
+
+		cfg := &jira4claude.Config{Server: serverURL, Project: "TEST", Netrc: netrcPath}
+		checks := jirahttp.NewDiagnosticService().Diagnose(context.Background(), cfg)
+
+		require.Len(t, checks, 4)
+		assert.Equal(t, jira4claude.EInternal, checks[1].Code)
+		assert.Contains(t, checks[1].Message, "unreachable")
+		assert.True(t, checks[2].Skipped)
+	})
+
+	t.Run("fails auth check with unauthorized code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/rest/api/3/myself" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errorMessages": ["Client must be authenticated"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		cfg := &jira4claude.Config{Server: server.URL, Project: "TEST", Netrc: writeNetrc(t, server.URL)}
+		checks := jirahttp.NewDiagnosticService().Diagnose(context.Background(), cfg)
+
+		require.Len(t, checks, 4)
+		assert.Empty(t, checks[1].Code)
+		assert.Equal(t, jirahttp.CheckAuth, checks[2].Name)
+		assert.Equal(t, jira4claude.EUnauthorized, checks[2].Code)
+		assert.True(t, checks[3].Skipped)
+	})
+
+	t.Run("fails project check with not found code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/rest/api/3/project/NOPE" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errorMessages": ["No project could be found with key 'NOPE'."]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"displayName": "Jane Doe"}`))
+		}))
+		defer server.Close()
+
+		cfg := &jira4claude.Config{Server: server.URL, Project: "NOPE", Netrc: writeNetrc(t, server.URL)}
+		checks := jirahttp.NewDiagnosticService().Diagnose(context.Background(), cfg)
+
+		require.Len(t, checks, 4)
+		assert.Equal(t, jirahttp.CheckProject, checks[3].Name)
+		assert.Equal(t, jira4claude.ENotFound, checks[3].Code)
+		assert.Contains(t, checks[3].Message, "NOPE")
+	})
+}
+
+// writeNetrc creates a temporary netrc file with credentials for baseURL.
+func writeNetrc(t *testing.T, baseURL string) string {
+	t.Helper()
+
+	u, err := url.Parse(baseURL)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "netrc")
+	content := fmt.Sprintf("machine %s\n  login user@example.com\n  password api-token\n", u.Host)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -55,6 +58,18 @@ func (s *IssueService) Create(ctx context.Context, issue *jira4claude.Issue) (*j
 	if len(issue.Labels) > 0 {
 		reqBody.Fields.Labels = issue.Labels
 	}
+	if len(issue.Components) > 0 {
+		reqBody.Fields.Components = nameRefs(issue.Components)
+	}
+	if len(issue.AffectsVersions) > 0 {
+		reqBody.Fields.Versions = nameRefs(issue.AffectsVersions)
+	}
+	if len(issue.FixVersions) > 0 {
+		reqBody.Fields.FixVersions = nameRefs(issue.FixVersions)
+	}
+	if issue.Assignee != nil && issue.Assignee.AccountID != "" {
+		reqBody.Fields.Assignee = &assigneeRef{AccountID: issue.Assignee.AccountID}
+	}
 	if issue.Parent != nil {
 		reqBody.Fields.Parent = &parentRef{Key: issue.Parent.Key}
 	}
@@ -117,7 +132,7 @@ func (s *IssueService) List(ctx context.Context, filter jira4claude.IssueFilter)
 
 	// Build request URL with query parameters
 	// The /search/jql endpoint requires explicit field selection
-	fields := "key,summary,status,issuetype,project,priority,assignee,reporter,labels,issuelinks,parent,created,updated,description"
+	fields := "key,summary,status,issuetype,project,priority,assignee,reporter,labels,components,versions,fixVersions,issuelinks,parent,created,updated,description"
 	reqURL := "/rest/api/3/search/jql?jql=" + url.QueryEscape(jql) + "&fields=" + fields
 	if filter.Limit > 0 {
 		reqURL += "&maxResults=" + strconv.Itoa(filter.Limit)
@@ -160,8 +175,8 @@ func (s *IssueService) List(ctx context.Context, filter jira4claude.IssueFilter)
 
 // buildJQL constructs a JQL query from IssueFilter fields.
 func buildJQL(filter jira4claude.IssueFilter) string {
-	// Pre-allocate for max possible clauses: project, status, excludeStatus, assignee, parent, + labels
-	clauses := make([]string, 0, 5+len(filter.Labels))
+	// Pre-allocate for max possible clauses: project, status, excludeStatus, assignee, parent, component, fixVersion, + labels
+	clauses := make([]string, 0, 7+len(filter.Labels))
 
 	if filter.Project != "" {
 		clauses = append(clauses, fmt.Sprintf("project = %q", filter.Project))
@@ -171,7 +186,11 @@ func buildJQL(filter jira4claude.IssueFilter) string {
 	} else if filter.ExcludeStatus != "" {
 		clauses = append(clauses, fmt.Sprintf("status != %q", filter.ExcludeStatus))
 	}
-	if filter.Assignee != "" {
+	switch filter.Assignee {
+	case "":
+	case jira4claude.UserMe:
+		clauses = append(clauses, "assignee = currentUser()")
+	default:
 		clauses = append(clauses, fmt.Sprintf("assignee = %q", filter.Assignee))
 	}
 	if filter.Parent != "" {
@@ -180,6 +199,12 @@ func buildJQL(filter jira4claude.IssueFilter) string {
 	for _, label := range filter.Labels {
 		clauses = append(clauses, fmt.Sprintf("labels = %q", label))
 	}
+	if filter.Component != "" {
+		clauses = append(clauses, fmt.Sprintf("component = %q", filter.Component))
+	}
+	if filter.FixVersion != "" {
+		clauses = append(clauses, fmt.Sprintf("fixVersion = %q", filter.FixVersion))
+	}
 
 	jql := strings.Join(clauses, " AND ")
 
@@ -214,6 +239,18 @@ func (s *IssueService) Update(ctx context.Context, key string, update jira4claud
 	if update.Labels != nil {
 		reqBody.Fields.Labels = update.Labels
 	}
+	if update.Components != nil {
+		refs := nameRefs(*update.Components)
+		reqBody.Fields.Components = &refs
+	}
+	if update.AffectsVersions != nil {
+		refs := nameRefs(*update.AffectsVersions)
+		reqBody.Fields.Versions = &refs
+	}
+	if update.FixVersions != nil {
+		refs := nameRefs(*update.FixVersions)
+		reqBody.Fields.FixVersions = &refs
+	}
 	if update.Parent != nil {
 		if *update.Parent == "" {
 			reqBody.Fields.Parent = &parentField{Key: nil}
@@ -222,6 +259,27 @@ func (s *IssueService) Update(ctx context.Context, key string, update jira4claud
 		}
 	}
 
+	// Jira rejects a field that is both replaced and changed incrementally
+	for _, f := range []struct {
+		name        string
+		replace     bool
+		add, remove []string
+	}{
+		{"labels", update.Labels != nil, update.AddLabels, update.RemoveLabels},
+		{"components", update.Components != nil, update.AddComponents, update.RemoveComponents},
+		{"fix versions", update.FixVersions != nil, update.AddFixVersions, update.RemoveFixVersions},
+	} {
+		if f.replace && (len(f.add) > 0 || len(f.remove) > 0) {
+			return nil, &jira4claude.Error{
+				Code:    jira4claude.EValidation,
+				Message: "cannot replace " + f.name + " and add or remove " + f.name + " in the same update",
+			}
+		}
+	}
+	reqBody.Update = updateOps(reqBody.Update, "labels", update.AddLabels, update.RemoveLabels, func(v string) any { return v })
+	reqBody.Update = updateOps(reqBody.Update, "components", update.AddComponents, update.RemoveComponents, func(v string) any { return nameRef{Name: v} })
+	reqBody.Update = updateOps(reqBody.Update, "fixVersions", update.AddFixVersions, update.RemoveFixVersions, func(v string) any { return nameRef{Name: v} })
+
 	req, err := s.client.NewJSONRequest(ctx, http.MethodPut, issuePath(key), reqBody)
 	if err != nil {
 		return nil, err
@@ -236,6 +294,24 @@ func (s *IssueService) Update(ctx context.Context, key string, update jira4claud
 	return s.Get(ctx, key)
 }
 
+// updateOps adds the add and remove verbs for field to ops, converting each
+// value with ref. Returns ops unchanged when there is nothing to add or remove.
+func updateOps(ops map[string][]updateOp, field string, add, remove []string, ref func(string) any) map[string][]updateOp {
+	if len(add) == 0 && len(remove) == 0 {
+		return ops
+	}
+	if ops == nil {
+		ops = make(map[string][]updateOp)
+	}
+	for _, v := range add {
+		ops[field] = append(ops[field], updateOp{Add: ref(v)})
+	}
+	for _, v := range remove {
+		ops[field] = append(ops[field], updateOp{Remove: ref(v)})
+	}
+	return ops
+}
+
 // Delete deletes an issue by its key.
 func (s *IssueService) Delete(ctx context.Context, key string) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, issuePath(key), nil)
@@ -272,7 +348,7 @@ func (s *IssueService) AddComment(ctx context.Context, key string, body jira4cla
 
 // Transitions returns available workflow transitions for an issue.
 func (s *IssueService) Transitions(ctx context.Context, key string) ([]*jira4claude.Transition, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuePath(key, "transitions"), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuePath(key, "transitions")+"?expand=transitions.fields", nil)
 	if err != nil {
 		return nil, &jira4claude.Error{
 			Code:    jira4claude.EInternal,
@@ -298,13 +374,29 @@ func (s *IssueService) Transitions(ctx context.Context, key string) ([]*jira4cla
 	return mapTransitions(transitionsResp.Transitions), nil
 }
 
-// Transition moves an issue to a new status.
-func (s *IssueService) Transition(ctx context.Context, key, transitionID string) error {
+// Transition moves an issue to a new status, setting the input values
+// on the transition screen.
+func (s *IssueService) Transition(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
 	reqBody := map[string]any{
 		"transition": map[string]any{
 			"id": transitionID,
 		},
 	}
+	fields := maps.Clone(input.Fields)
+	if input.Resolution != "" {
+		if fields == nil {
+			fields = map[string]any{}
+		}
+		fields["resolution"] = map[string]any{"name": input.Resolution}
+	}
+	if len(fields) > 0 {
+		reqBody["fields"] = fields
+	}
+	if input.Comment != nil {
+		reqBody["update"] = map[string]any{
+			"comment": []any{map[string]any{"add": map[string]any{"body": input.Comment}}},
+		}
+	}
 
 	req, err := s.client.NewJSONRequest(ctx, http.MethodPost, issuePath(key, "transitions"), reqBody)
 	if err != nil {
@@ -334,6 +426,68 @@ func (s *IssueService) Assign(ctx context.Context, key, accountID string) error
 	return err
 }
 
+// watchersResponse represents the Jira API response for an issue's watchers.
+type watchersResponse struct {
+	Watchers []userResponse `json:"watchers"`
+}
+
+// Watchers returns the users watching an issue.
+func (s *IssueService) Watchers(ctx context.Context, key string) ([]*jira4claude.User, error) {
+	var resp watchersResponse
+	if err := getJSON(ctx, s.client, issuePath(key, "watchers"), &resp); err != nil {
+		return nil, err
+	}
+
+	users := make([]*jira4claude.User, len(resp.Watchers))
+	for i := range resp.Watchers {
+		users[i] = mapUser(&resp.Watchers[i])
+	}
+	return users, nil
+}
+
+// AddWatcher adds a user to an issue's watchers by account ID.
+func (s *IssueService) AddWatcher(ctx context.Context, key, accountID string) error {
+	// The API takes the bare account ID as a JSON string.
+	req, err := s.client.NewJSONRequest(ctx, http.MethodPost, issuePath(key, "watchers"), accountID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.DoRequest(req, http.StatusNoContent)
+	return err
+}
+
+// RemoveWatcher removes a user from an issue's watchers by account ID.
+func (s *IssueService) RemoveWatcher(ctx context.Context, key, accountID string) error {
+	path := issuePath(key, "watchers") + "?accountId=" + url.QueryEscape(accountID)
+	return s.sendNoContent(ctx, http.MethodDelete, path)
+}
+
+// Vote adds the authenticated user's vote to an issue.
+func (s *IssueService) Vote(ctx context.Context, key string) error {
+	return s.sendNoContent(ctx, http.MethodPost, issuePath(key, "votes"))
+}
+
+// Unvote removes the authenticated user's vote from an issue.
+func (s *IssueService) Unvote(ctx context.Context, key string) error {
+	return s.sendNoContent(ctx, http.MethodDelete, issuePath(key, "votes"))
+}
+
+// sendNoContent sends a request without a body and expects 204 No Content.
+func (s *IssueService) sendNoContent(ctx context.Context, method, path string) error {
+	req, err := http.NewRequestWithContext(ctx, method, path, nil)
+	if err != nil {
+		return &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to create request",
+			Inner:   err,
+		}
+	}
+
+	_, err = s.client.DoRequest(req, http.StatusNoContent)
+	return err
+}
+
 // issueResponse represents the JSON structure returned by Jira API for an issue.
 type issueResponse struct {
 	Key    string `json:"key"`
@@ -347,6 +501,9 @@ type issueResponse struct {
 		Assignee    *userResponse         `json:"assignee"`
 		Reporter    *userResponse         `json:"reporter"`
 		Labels      []string              `json:"labels"`
+		Components  []nameRef             `json:"components"`
+		Versions    []nameRef             `json:"versions"`
+		FixVersions []nameRef             `json:"fixVersions"`
 		IssueLinks  []issueLinkResponse   `json:"issuelinks"`
 		Subtasks    []linkedIssueResponse `json:"subtasks"`
 		Comment     *commentsResponse     `json:"comment"`
@@ -402,6 +559,23 @@ type userResponse struct {
 type transitionResponse struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	To   struct {
+		Name           string `json:"name"`
+		StatusCategory struct {
+			Key string `json:"key"`
+		} `json:"statusCategory"`
+	} `json:"to"`
+	Fields map[string]transitionFieldResponse `json:"fields"`
+}
+
+// transitionFieldResponse represents a transition screen field in the Jira API response.
+type transitionFieldResponse struct {
+	Name          string `json:"name"`
+	Required      bool   `json:"required"`
+	AllowedValues []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"` // Select list options use value instead of name
+	} `json:"allowedValues"`
 }
 
 // createIssueResponse represents the JSON structure returned by Jira API when creating an issue.
@@ -419,25 +593,6 @@ type transitionsResponse struct {
 	Transitions []transitionResponse `json:"transitions"`
 }
 
-// findLinkIssueResponse represents the minimal issue structure for finding links.
-type findLinkIssueResponse struct {
-	Fields struct {
-		IssueLinks []findLinkIssueLinkResponse `json:"issuelinks"`
-	} `json:"fields"`
-}
-
-// findLinkIssueLinkResponse represents a link entry when searching for a specific link.
-type findLinkIssueLinkResponse struct {
-	ID           string                  `json:"id"`
-	OutwardIssue *findLinkLinkedResponse `json:"outwardIssue"`
-	InwardIssue  *findLinkLinkedResponse `json:"inwardIssue"`
-}
-
-// findLinkLinkedResponse represents a linked issue key when searching for a specific link.
-type findLinkLinkedResponse struct {
-	Key string `json:"key"`
-}
-
 // parseIssueResponse parses the JSON response from Jira into a domain Issue.
 func parseIssueResponse(body []byte) (*jira4claude.Issue, error) {
 	var resp issueResponse
@@ -460,6 +615,10 @@ func parseIssueResponse(body []byte) (*jira4claude.Issue, error) {
 		Labels:      resp.Fields.Labels,
 	}
 
+	issue.Components = mapNames(resp.Fields.Components)
+	issue.AffectsVersions = mapNames(resp.Fields.Versions)
+	issue.FixVersions = mapNames(resp.Fields.FixVersions)
+
 	issue.Parent = mapLinkedIssue(resp.Fields.Parent)
 
 	issue.Assignee = mapUser(resp.Fields.Assignee)
@@ -496,6 +655,18 @@ func mapUser(resp *userResponse) *jira4claude.User {
 	}
 }
 
+// mapNames returns the names of component or version references. Returns nil if input is empty.
+func mapNames(refs []nameRef) []string {
+	if len(refs) == 0 {
+		return nil
+	}
+	names := make([]string, len(refs))
+	for i, ref := range refs {
+		names[i] = ref.Name
+	}
+	return names
+}
+
 // mapLinkedIssue converts a linkedIssueResponse to a domain LinkedIssue. Returns nil if input is nil.
 func mapLinkedIssue(resp *linkedIssueResponse) *jira4claude.LinkedIssue {
 	if resp == nil {
@@ -572,9 +743,30 @@ func mapTransitions(transitions []transitionResponse) []*jira4claude.Transition
 	result := make([]*jira4claude.Transition, len(transitions))
 	for i, t := range transitions {
 		result[i] = &jira4claude.Transition{
-			ID:   t.ID,
-			Name: t.Name,
+			ID:       t.ID,
+			Name:     t.Name,
+			To:       t.To.Name,
+			Category: t.To.StatusCategory.Key,
+			Fields:   mapTransitionFields(t.Fields),
+		}
+	}
+	return result
+}
+
+// mapTransitionFields converts transition screen fields to domain fields
+// sorted by ID. Returns nil if input is empty.
+func mapTransitionFields(fields map[string]transitionFieldResponse) []*jira4claude.TransitionField {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make([]*jira4claude.TransitionField, 0, len(fields))
+	for _, id := range slices.Sorted(maps.Keys(fields)) {
+		f := fields[id]
+		field := &jira4claude.TransitionField{ID: id, Name: f.Name, Required: f.Required}
+		for _, v := range f.AllowedValues {
+			field.AllowedValues = append(field.AllowedValues, cmp.Or(v.Name, v.Value))
 		}
+		result = append(result, field)
 	}
 	return result
 }
@@ -601,69 +793,106 @@ func (s *IssueService) Link(ctx context.Context, inwardKey, linkType, outwardKey
 	return err
 }
 
-// Unlink removes a link between two issues.
-func (s *IssueService) Unlink(ctx context.Context, key1, key2 string) error {
-	// Fetch the first issue to find the link
-	linkID, err := s.findLinkID(ctx, key1, key2)
-	if err != nil {
-		return err
+// linkTypesResponse represents the Jira API response listing link types.
+type linkTypesResponse struct {
+	IssueLinkTypes []struct {
+		Name    string `json:"name"`
+		Inward  string `json:"inward"`
+		Outward string `json:"outward"`
+	} `json:"issueLinkTypes"`
+}
+
+// LinkTypes returns the issue link types configured on the site.
+func (s *IssueService) LinkTypes(ctx context.Context) ([]*jira4claude.IssueLinkType, error) {
+	var resp linkTypesResponse
+	if err := getJSON(ctx, s.client, "/rest/api/3/issueLinkType", &resp); err != nil {
+		return nil, err
 	}
 
-	// Delete the link
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/rest/api/3/issueLink/"+url.PathEscape(linkID), nil)
-	if err != nil {
-		return &jira4claude.Error{
-			Code:    jira4claude.EInternal,
-			Message: "failed to create request",
-			Inner:   err,
-		}
+	types := make([]*jira4claude.IssueLinkType, len(resp.IssueLinkTypes))
+	for i, t := range resp.IssueLinkTypes {
+		types[i] = &jira4claude.IssueLinkType{Name: t.Name, Inward: t.Inward, Outward: t.Outward}
 	}
+	return types, nil
+}
 
-	_, err = s.client.DoRequest(req, http.StatusNoContent)
-	return err
+// DeleteLink deletes an issue link by its ID.
+func (s *IssueService) DeleteLink(ctx context.Context, linkID string) error {
+	return s.sendNoContent(ctx, http.MethodDelete, "/rest/api/3/issueLink/"+url.PathEscape(linkID))
 }
 
-// findLinkID finds the link ID connecting two issues.
-func (s *IssueService) findLinkID(ctx context.Context, key1, key2 string) (string, error) {
-	// Fetch issue with links
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuePath(key1), nil)
-	if err != nil {
-		return "", &jira4claude.Error{
-			Code:    jira4claude.EInternal,
-			Message: "failed to create request",
-			Inner:   err,
+// remoteLinkObject is the linked resource of a remote link.
+type remoteLinkObject struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// remoteLinkResponse represents a remote link in the Jira API.
+type remoteLinkResponse struct {
+	ID           json.Number      `json:"id"`
+	Relationship string           `json:"relationship"`
+	Object       remoteLinkObject `json:"object"`
+}
+
+// remoteLinkRequest represents the request body for creating a remote link.
+type remoteLinkRequest struct {
+	Relationship string           `json:"relationship,omitempty"`
+	Object       remoteLinkObject `json:"object"`
+}
+
+// RemoteLinks returns the web links of an issue.
+func (s *IssueService) RemoteLinks(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+	var resp []remoteLinkResponse
+	if err := getJSON(ctx, s.client, issuePath(key, "remotelink"), &resp); err != nil {
+		return nil, err
+	}
+
+	links := make([]*jira4claude.RemoteLink, len(resp))
+	for i, r := range resp {
+		links[i] = &jira4claude.RemoteLink{
+			ID:           r.ID.String(),
+			URL:          r.Object.URL,
+			Title:        r.Object.Title,
+			Relationship: r.Relationship,
 		}
 	}
+	return links, nil
+}
+
+// AddRemoteLink adds a web link to an issue and returns it with ID populated.
+// Jira requires a title, so the URL is used when the link has none.
+func (s *IssueService) AddRemoteLink(ctx context.Context, key string, link *jira4claude.RemoteLink) (*jira4claude.RemoteLink, error) {
+	reqBody := remoteLinkRequest{
+		Relationship: link.Relationship,
+		Object: remoteLinkObject{
+			URL:   link.URL,
+			Title: cmp.Or(link.Title, link.URL),
+		},
+	}
 
-	respBody, err := s.client.DoRequest(req, http.StatusOK)
+	req, err := s.client.NewJSONRequest(ctx, http.MethodPost, issuePath(key, "remotelink"), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := s.client.DoRequest(req, http.StatusCreated)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Parse the issue to find the link
-	var issueResp findLinkIssueResponse
-	if err := json.Unmarshal(respBody, &issueResp); err != nil {
-		return "", &jira4claude.Error{
+	var resp remoteLinkResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, &jira4claude.Error{
 			Code:    jira4claude.EInternal,
 			Message: "failed to parse response",
 			Inner:   err,
 		}
 	}
-
-	// Find the link to the target issue
-	for _, link := range issueResp.Fields.IssueLinks {
-		if link.OutwardIssue != nil && link.OutwardIssue.Key == key2 {
-			return link.ID, nil
-		}
-		if link.InwardIssue != nil && link.InwardIssue.Key == key2 {
-			return link.ID, nil
-		}
-	}
-
-	return "", &jira4claude.Error{
-		Code:    jira4claude.ENotFound,
-		Message: fmt.Sprintf("no link found between %s and %s", key1, key2),
-	}
+	return &jira4claude.RemoteLink{
+		ID:           resp.ID.String(),
+		URL:          reqBody.Object.URL,
+		Title:        reqBody.Object.Title,
+		Relationship: link.Relationship,
+	}, nil
 }
 
 // commentResponse represents the JSON structure returned by Jira API for a comment.
@@ -252,6 +252,45 @@ func TestIssueService_Create(t *testing.T) {
 		_, hasParent := fields["parent"]
 		assert.False(t, hasParent)
 	})
+
+	t.Run("sends components, versions and assignee", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key": "TEST-5"}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		issue := &jira4claude.Issue{
+			Project:         "TEST",
+			Summary:         "Component issue",
+			Type:            "Task",
+			Components:      []string{"API", "UI"},
+			AffectsVersions: []string{"1.0"},
+			FixVersions:     []string{"1.1"},
+			Assignee:        &jira4claude.User{AccountID: "acc-123"},
+		}
+
+		_, err := svc.Create(context.Background(), issue)
+
+		require.NoError(t, err)
+
+		fields := receivedRequest["fields"].(map[string]any)
+		assert.Equal(t, []any{
+			map[string]any{"name": "API"},
+			map[string]any{"name": "UI"},
+		}, fields["components"])
+		assert.Equal(t, []any{map[string]any{"name": "1.0"}}, fields["versions"])
+		assert.Equal(t, []any{map[string]any{"name": "1.1"}}, fields["fixVersions"])
+		assert.Equal(t, map[string]any{"accountId": "acc-123"}, fields["assignee"])
+	})
 }
 
 func TestIssueService_Get(t *testing.T) {
@@ -316,6 +355,9 @@ func TestIssueService_Get(t *testing.T) {
 					"assignee": {"accountId": "123", "displayName": "John Doe", "emailAddress": "john@example.com"},
 					"reporter": {"accountId": "456", "displayName": "Jane Smith", "emailAddress": "jane@example.com"},
 					"labels": ["bug", "urgent"],
+					"components": [{"id": "10", "name": "API"}],
+					"versions": [{"id": "20", "name": "1.0"}],
+					"fixVersions": [{"id": "21", "name": "1.1"}, {"id": "22", "name": "2.0"}],
 					"created": "2024-01-15T10:30:00.000+0000",
 					"updated": "2024-01-16T14:20:00.000+0000"
 				}
@@ -342,6 +384,9 @@ func TestIssueService_Get(t *testing.T) {
 		assert.Equal(t, "John Doe", issue.Assignee.DisplayName)
 		assert.NotNil(t, issue.Reporter)
 		assert.Equal(t, []string{"bug", "urgent"}, issue.Labels)
+		assert.Equal(t, []string{"API"}, issue.Components)
+		assert.Equal(t, []string{"1.0"}, issue.AffectsVersions)
+		assert.Equal(t, []string{"1.1", "2.0"}, issue.FixVersions)
 	})
 
 	t.Run("returns not found error for missing issue", func(t *testing.T) {
@@ -884,6 +929,34 @@ func TestIssueService_List(t *testing.T) {
 		assert.Contains(t, receivedJQL, "labels = \"urgent\"")
 	})
 
+	t.Run("includes component and fix version in JQL filter", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedJQL, receivedFields string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedJQL = r.URL.Query().Get("jql")
+			receivedFields = r.URL.Query().Get("fields")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"issues": []}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		_, err := svc.List(context.Background(), jira4claude.IssueFilter{
+			Project:    "TEST",
+			Component:  "API",
+			FixVersion: "1.2",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, receivedJQL, "component = \"API\"")
+		assert.Contains(t, receivedJQL, "fixVersion = \"1.2\"")
+		assert.Contains(t, receivedFields, "components")
+		assert.Contains(t, receivedFields, "fixVersions")
+	})
+
 	t.Run("includes parent in JQL filter", func(t *testing.T) {
 		t.Parallel()
 
@@ -965,6 +1038,26 @@ func TestIssueService_List(t *testing.T) {
 		assert.Contains(t, receivedJQL, " AND ")
 	})
 
+	t.Run("maps me assignee to current user", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedJQL string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedJQL = r.URL.Query().Get("jql")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"issues": []}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		_, err := svc.List(context.Background(), jira4claude.IssueFilter{Assignee: jira4claude.UserMe})
+
+		require.NoError(t, err)
+		assert.Equal(t, "assignee = currentUser()", receivedJQL)
+	})
+
 	t.Run("sends empty JQL when no filters provided", func(t *testing.T) {
 		t.Parallel()
 
@@ -1432,6 +1525,149 @@ func TestIssueService_Update(t *testing.T) {
 		_, hasParent := fields["parent"]
 		assert.False(t, hasParent)
 	})
+
+	t.Run("sends incremental changes as update verbs", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"project": {"key": "TEST"}, "summary": "Test", "status": {"name": "To Do"}, "issuetype": {"name": "Task"}}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		_, err := svc.Update(context.Background(), "TEST-1", jira4claude.IssueUpdate{
+			AddLabels:         []string{"urgent"},
+			RemoveLabels:      []string{"triage"},
+			AddComponents:     []string{"API"},
+			RemoveFixVersions: []string{"1.0"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"labels": []any{
+				map[string]any{"add": "urgent"},
+				map[string]any{"remove": "triage"},
+			},
+			"components": []any{
+				map[string]any{"add": map[string]any{"name": "API"}},
+			},
+			"fixVersions": []any{
+				map[string]any{"remove": map[string]any{"name": "1.0"}},
+			},
+		}, receivedRequest["update"])
+		assert.Empty(t, receivedRequest["fields"])
+	})
+
+	t.Run("omits update verbs when there are no incremental changes", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"project": {"key": "TEST"}, "summary": "Test", "status": {"name": "To Do"}, "issuetype": {"name": "Task"}}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		labels := []string{"a"}
+		_, err := svc.Update(context.Background(), "TEST-1", jira4claude.IssueUpdate{Labels: &labels})
+
+		require.NoError(t, err)
+		_, hasUpdate := receivedRequest["update"]
+		assert.False(t, hasUpdate)
+	})
+
+	t.Run("replaces components and versions", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"project": {"key": "TEST"}, "summary": "Test", "status": {"name": "To Do"}, "issuetype": {"name": "Task"}}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		components := []string{"API"}
+		affects := []string{"1.0"}
+		fixes := []string{}
+		_, err := svc.Update(context.Background(), "TEST-1", jira4claude.IssueUpdate{
+			Components:      &components,
+			AffectsVersions: &affects,
+			FixVersions:     &fixes,
+		})
+
+		require.NoError(t, err)
+		fields := receivedRequest["fields"].(map[string]any)
+		assert.Equal(t, []any{map[string]any{"name": "API"}}, fields["components"])
+		assert.Equal(t, []any{map[string]any{"name": "1.0"}}, fields["versions"])
+		assert.Equal(t, []any{}, fields["fixVersions"])
+	})
+
+	t.Run("rejects replacing and changing fix versions together", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("unexpected request")
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		fixes := []string{"1.0"}
+		_, err := svc.Update(context.Background(), "TEST-1", jira4claude.IssueUpdate{
+			FixVersions:       &fixes,
+			RemoveFixVersions: []string{"0.9"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("rejects replacing and changing labels together", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("unexpected request")
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		labels := []string{"a"}
+		_, err := svc.Update(context.Background(), "TEST-1", jira4claude.IssueUpdate{
+			Labels:    &labels,
+			AddLabels: []string{"b"},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
 }
 
 func TestIssueService_AddComment(t *testing.T) {
@@ -1698,7 +1934,11 @@ func TestIssueService_Transitions(t *testing.T) {
 				"transitions": [
 					{"id": "11", "name": "To Do"},
 					{"id": "21", "name": "In Progress"},
-					{"id": "31", "name": "Done"}
+					{"id": "31", "name": "Close", "to": {"name": "Done", "statusCategory": {"key": "done"}}, "fields": {
+						"resolution": {"name": "Resolution", "required": true, "allowedValues": [{"id": "1", "name": "Done"}, {"id": "2", "name": "Won't Do"}]},
+						"customfield_10010": {"name": "Severity", "required": false, "allowedValues": [{"id": "9", "value": "High"}]},
+						"comment": {"name": "Comment", "required": false}
+					}}
 				]
 			}`))
 		}))
@@ -1716,7 +1956,14 @@ func TestIssueService_Transitions(t *testing.T) {
 		assert.Equal(t, "21", transitions[1].ID)
 		assert.Equal(t, "In Progress", transitions[1].Name)
 		assert.Equal(t, "31", transitions[2].ID)
-		assert.Equal(t, "Done", transitions[2].Name)
+		assert.Equal(t, "Close", transitions[2].Name)
+		assert.Equal(t, "Done", transitions[2].To)
+		assert.Equal(t, jira4claude.StatusCategoryDone, transitions[2].Category)
+		assert.Equal(t, []*jira4claude.TransitionField{
+			{ID: "comment", Name: "Comment"},
+			{ID: "customfield_10010", Name: "Severity", AllowedValues: []string{"High"}},
+			{ID: "resolution", Name: "Resolution", Required: true, AllowedValues: []string{"Done", "Won't Do"}},
+		}, transitions[2].Fields)
 	})
 
 	t.Run("returns error when issue not found", func(t *testing.T) {
@@ -1777,13 +2024,65 @@ func TestIssueService_Transition(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Transition(context.Background(), "TEST-1", "21")
+		err := svc.Transition(context.Background(), "TEST-1", "21", jira4claude.TransitionInput{})
 
 		require.NoError(t, err)
 
 		// Verify request structure
 		transition := receivedRequest["transition"].(map[string]any)
 		assert.Equal(t, "21", transition["id"])
+		assert.NotContains(t, receivedRequest, "fields")
+	})
+
+	t.Run("sets resolution on the transition screen", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		err := svc.Transition(context.Background(), "TEST-1", "31", jira4claude.TransitionInput{Resolution: "Won't Do"})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"resolution": map[string]any{"name": "Won't Do"},
+		}, receivedRequest["fields"])
+	})
+
+	t.Run("sends screen fields and comment", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		comment := jira4claude.ADF{"type": "doc", "version": float64(1), "content": []any{}}
+		err := svc.Transition(context.Background(), "TEST-1", "31", jira4claude.TransitionInput{
+			Resolution: "Done",
+			Fields:     map[string]any{"fixVersions": []any{map[string]any{"name": "1.2"}}},
+			Comment:    comment,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"resolution":  map[string]any{"name": "Done"},
+			"fixVersions": []any{map[string]any{"name": "1.2"}},
+		}, receivedRequest["fields"])
+		assert.Equal(t, map[string]any{
+			"comment": []any{map[string]any{"add": map[string]any{"body": map[string]any(comment)}}},
+		}, receivedRequest["update"])
 	})
 
 	t.Run("returns error when issue not found", func(t *testing.T) {
@@ -1798,7 +2097,7 @@ func TestIssueService_Transition(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Transition(context.Background(), "NOTFOUND-1", "21")
+		err := svc.Transition(context.Background(), "NOTFOUND-1", "21", jira4claude.TransitionInput{})
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
@@ -1816,13 +2115,121 @@ func TestIssueService_Transition(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Transition(context.Background(), "TEST-1", "999")
+		err := svc.Transition(context.Background(), "TEST-1", "999", jira4claude.TransitionInput{})
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
 	})
 }
 
+func TestIssueService_RemoteLinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lists web links", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/TEST-1/remotelink" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`[
+				{
+					"id": 10000,
+					"self": "https://example.atlassian.net/rest/api/3/issue/TEST-1/remotelink/10000",
+					"relationship": "pull request",
+					"object": {"url": "https://github.com/org/repo/pull/42", "title": "PR #42"}
+				},
+				{"id": 10001, "object": {"url": "https://ci.example.com/runs/7", "title": "CI run"}}
+			]`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		links, err := svc.RemoteLinks(context.Background(), "TEST-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []*jira4claude.RemoteLink{
+			{ID: "10000", URL: "https://github.com/org/repo/pull/42", Title: "PR #42", Relationship: "pull request"},
+			{ID: "10001", URL: "https://ci.example.com/runs/7", Title: "CI run"},
+		}, links)
+	})
+
+	t.Run("adds web link using the URL as default title", func(t *testing.T) {
+		t.Parallel()
+
+		var received map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/TEST-1/remotelink" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 10002, "self": "https://example.atlassian.net/rest/api/3/issue/TEST-1/remotelink/10002"}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		link, err := svc.AddRemoteLink(context.Background(), "TEST-1", &jira4claude.RemoteLink{URL: "https://ci.example.com/runs/7"})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"object": map[string]any{"url": "https://ci.example.com/runs/7", "title": "https://ci.example.com/runs/7"},
+		}, received)
+		assert.Equal(t, &jira4claude.RemoteLink{ID: "10002", URL: "https://ci.example.com/runs/7", Title: "https://ci.example.com/runs/7"}, link)
+	})
+
+	t.Run("returns error when issue not found", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Issue does not exist"], "errors": {}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		_, err := svc.RemoteLinks(context.Background(), "NOTFOUND-1")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestIssueService_LinkTypes(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issueLinkType" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"issueLinkTypes": [
+			{"id": "10000", "name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+			{"id": "10003", "name": "Relates", "inward": "relates to", "outward": "relates to"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "user@example.com", "api-token")
+	svc := jirahttp.NewIssueService(client)
+
+	types, err := svc.LinkTypes(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []*jira4claude.IssueLinkType{
+		{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+		{Name: "Relates", Outward: "relates to", Inward: "relates to"},
+	}, types)
+}
+
 func TestIssueService_Link(t *testing.T) {
 	t.Parallel()
 
@@ -1896,51 +2303,20 @@ func TestIssueService_Link(t *testing.T) {
 	})
 }
 
-func TestIssueService_Unlink(t *testing.T) {
+func TestIssueService_DeleteLink(t *testing.T) {
 	t.Parallel()
 
-	t.Run("removes link between issues", func(t *testing.T) {
+	t.Run("deletes link by ID", func(t *testing.T) {
 		t.Parallel()
 
 		var deletedLinkID string
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// First, the service fetches the issue to find the link
-			if r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/TEST-1" {
-				w.Header().Set("Content-Type", "application/json")
-				_, _ = w.Write([]byte(`{
-					"key": "TEST-1",
-					"fields": {
-						"project": {"key": "TEST"},
-						"summary": "Test issue",
-						"status": {"name": "To Do"},
-						"issuetype": {"name": "Task"},
-						"issuelinks": [
-							{
-								"id": "10001",
-								"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
-								"outwardIssue": {
-									"key": "TEST-2",
-									"fields": {
-										"summary": "Target issue",
-										"status": {"name": "To Do"},
-										"issuetype": {"name": "Task"}
-									}
-								}
-							}
-						]
-					}
-				}`))
-				return
-			}
-
-			// Then, it deletes the link
 			const linkPath = "/rest/api/3/issueLink/"
 			if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, linkPath) {
 				deletedLinkID = strings.TrimPrefix(r.URL.Path, linkPath)
 				w.WriteHeader(http.StatusNoContent)
 				return
 			}
-
 			w.WriteHeader(http.StatusNotFound)
 		}))
 		defer server.Close()
@@ -1948,94 +2324,80 @@ func TestIssueService_Unlink(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Unlink(context.Background(), "TEST-1", "TEST-2")
+		err := svc.DeleteLink(context.Background(), "10001")
 
 		require.NoError(t, err)
 		assert.Equal(t, "10001", deletedLinkID)
 	})
 
-	t.Run("removes link when issues are in reverse order", func(t *testing.T) {
+	t.Run("returns error when link not found", func(t *testing.T) {
 		t.Parallel()
 
-		var deletedLinkID string
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/TEST-2" {
-				w.Header().Set("Content-Type", "application/json")
-				_, _ = w.Write([]byte(`{
-					"key": "TEST-2",
-					"fields": {
-						"project": {"key": "TEST"},
-						"summary": "Test issue",
-						"status": {"name": "To Do"},
-						"issuetype": {"name": "Task"},
-						"issuelinks": [
-							{
-								"id": "10002",
-								"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
-								"inwardIssue": {
-									"key": "TEST-1",
-									"fields": {
-										"summary": "Source issue",
-										"status": {"name": "To Do"},
-										"issuetype": {"name": "Task"}
-									}
-								}
-							}
-						]
-					}
-				}`))
-				return
-			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages": ["No issue link with id '99999' exists."], "errors": {}}`))
+		}))
+		defer server.Close()
 
-			const linkPath = "/rest/api/3/issueLink/"
-			if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, linkPath) {
-				deletedLinkID = strings.TrimPrefix(r.URL.Path, linkPath)
-				w.WriteHeader(http.StatusNoContent)
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		err := svc.DeleteLink(context.Background(), "99999")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestIssueService_Assign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("assigns issue to user", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/rest/api/3/issue/TEST-1/assignee" {
+				w.WriteHeader(http.StatusNotFound)
 				return
 			}
 
-			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.WriteHeader(http.StatusNoContent)
 		}))
 		defer server.Close()
 
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Unlink(context.Background(), "TEST-2", "TEST-1")
+		err := svc.Assign(context.Background(), "TEST-1", "abc123")
 
 		require.NoError(t, err)
-		assert.Equal(t, "10002", deletedLinkID)
+		assert.Equal(t, "abc123", receivedRequest["accountId"])
 	})
 
-	t.Run("returns error when no link exists", func(t *testing.T) {
+	t.Run("unassigns issue when accountID is empty", func(t *testing.T) {
 		t.Parallel()
 
+		var receivedRequest map[string]any
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/TEST-1" {
-				w.Header().Set("Content-Type", "application/json")
-				_, _ = w.Write([]byte(`{
-					"key": "TEST-1",
-					"fields": {
-						"project": {"key": "TEST"},
-						"summary": "Test issue",
-						"status": {"name": "To Do"},
-						"issuetype": {"name": "Task"},
-						"issuelinks": []
-					}
-				}`))
+			if r.Method != http.MethodPut || r.URL.Path != "/rest/api/3/issue/TEST-1/assignee" {
+				w.WriteHeader(http.StatusNotFound)
 				return
 			}
-			w.WriteHeader(http.StatusNotFound)
+
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.WriteHeader(http.StatusNoContent)
 		}))
 		defer server.Close()
 
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Unlink(context.Background(), "TEST-1", "TEST-2")
+		err := svc.Assign(context.Background(), "TEST-1", "")
 
-		require.Error(t, err)
-		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		require.NoError(t, err)
+		assert.Nil(t, receivedRequest["accountId"])
 	})
 
 	t.Run("returns error when issue not found", func(t *testing.T) {
@@ -2050,27 +2412,57 @@ func TestIssueService_Unlink(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Unlink(context.Background(), "NOTFOUND-1", "TEST-2")
+		err := svc.Assign(context.Background(), "NOTFOUND-1", "abc123")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
 	})
 }
 
-func TestIssueService_Assign(t *testing.T) {
+func TestIssueService_Watchers(t *testing.T) {
 	t.Parallel()
 
-	t.Run("assigns issue to user", func(t *testing.T) {
+	t.Run("lists watchers", func(t *testing.T) {
 		t.Parallel()
 
-		var receivedRequest map[string]any
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPut || r.URL.Path != "/rest/api/3/issue/TEST-1/assignee" {
+			if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
 				w.WriteHeader(http.StatusNotFound)
 				return
 			}
+			_, _ = w.Write([]byte(`{
+				"isWatching": true,
+				"watchCount": 2,
+				"watchers": [
+					{"accountId": "acc-1", "displayName": "Jane Doe", "emailAddress": "jane@example.com"},
+					{"accountId": "acc-2", "displayName": "John Roe"}
+				]
+			}`))
+		}))
+		defer server.Close()
 
-			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewIssueService(client)
+
+		users, err := svc.Watchers(context.Background(), "TEST-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []*jira4claude.User{
+			{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+			{AccountID: "acc-2", DisplayName: "John Roe"},
+		}, users)
+	})
+
+	t.Run("adds watcher with account ID as JSON string", func(t *testing.T) {
+		t.Parallel()
+
+		var received string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&received)
 			w.WriteHeader(http.StatusNoContent)
 		}))
 		defer server.Close()
@@ -2078,23 +2470,22 @@ func TestIssueService_Assign(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Assign(context.Background(), "TEST-1", "abc123")
+		err := svc.AddWatcher(context.Background(), "TEST-1", "acc-1")
 
 		require.NoError(t, err)
-		assert.Equal(t, "abc123", receivedRequest["accountId"])
+		assert.Equal(t, "acc-1", received)
 	})
 
-	t.Run("unassigns issue when accountID is empty", func(t *testing.T) {
+	t.Run("removes watcher by account ID", func(t *testing.T) {
 		t.Parallel()
 
-		var receivedRequest map[string]any
+		var accountID string
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPut || r.URL.Path != "/rest/api/3/issue/TEST-1/assignee" {
+			if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
 				w.WriteHeader(http.StatusNotFound)
 				return
 			}
-
-			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			accountID = r.URL.Query().Get("accountId")
 			w.WriteHeader(http.StatusNoContent)
 		}))
 		defer server.Close()
@@ -2102,10 +2493,10 @@ func TestIssueService_Assign(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Assign(context.Background(), "TEST-1", "")
+		err := svc.RemoveWatcher(context.Background(), "TEST-1", "557058:f58131cb")
 
 		require.NoError(t, err)
-		assert.Nil(t, receivedRequest["accountId"])
+		assert.Equal(t, "557058:f58131cb", accountID)
 	})
 
 	t.Run("returns error when issue not found", func(t *testing.T) {
@@ -2120,9 +2511,49 @@ func TestIssueService_Assign(t *testing.T) {
 		client := newTestClient(t, server.URL, "user@example.com", "api-token")
 		svc := jirahttp.NewIssueService(client)
 
-		err := svc.Assign(context.Background(), "NOTFOUND-1", "abc123")
+		_, err := svc.Watchers(context.Background(), "NOTFOUND-1")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
 	})
 }
+
+func TestIssueService_Vote(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, method *string) *httptest.Server {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rest/api/3/issue/TEST-1/votes" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			*method = r.Method
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("votes with POST", func(t *testing.T) {
+		t.Parallel()
+
+		var method string
+		server := newServer(t, &method)
+		svc := jirahttp.NewIssueService(newTestClient(t, server.URL, "user@example.com", "api-token"))
+
+		require.NoError(t, svc.Vote(context.Background(), "TEST-1"))
+		assert.Equal(t, http.MethodPost, method)
+	})
+
+	t.Run("removes vote with DELETE", func(t *testing.T) {
+		t.Parallel()
+
+		var method string
+		server := newServer(t, &method)
+		svc := jirahttp.NewIssueService(newTestClient(t, server.URL, "user@example.com", "api-token"))
+
+		require.NoError(t, svc.Unvote(context.Background(), "TEST-1"))
+		assert.Equal(t, http.MethodDelete, method)
+	})
+}
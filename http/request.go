@@ -15,6 +15,10 @@ type createFields struct {
 	Description any          `json:"description,omitempty"`
 	Priority    *priorityRef `json:"priority,omitempty"`
 	Labels      []string     `json:"labels,omitempty"`
+	Components  []nameRef    `json:"components,omitempty"`
+	Versions    []nameRef    `json:"versions,omitempty"`
+	FixVersions []nameRef    `json:"fixVersions,omitempty"`
+	Assignee    *assigneeRef `json:"assignee,omitempty"`
 	Parent      *parentRef   `json:"parent,omitempty"`
 }
 
@@ -33,14 +37,37 @@ type priorityRef struct {
 	Name string `json:"name"`
 }
 
+// nameRef identifies a component or version by name.
+type nameRef struct {
+	Name string `json:"name"`
+}
+
+// nameRefs converts names to name references.
+func nameRefs(names []string) []nameRef {
+	refs := make([]nameRef, len(names))
+	for i, name := range names {
+		refs[i] = nameRef{Name: name}
+	}
+	return refs
+}
+
 // parentRef identifies a parent issue by key.
 type parentRef struct {
 	Key string `json:"key"`
 }
 
 // updateRequest represents the request body for updating a Jira issue.
+// Fields replaces values; Update applies add/remove verbs per field.
 type updateRequest struct {
-	Fields updateFields `json:"fields"`
+	Fields updateFields          `json:"fields"`
+	Update map[string][]updateOp `json:"update,omitempty"`
+}
+
+// updateOp is a single Jira update verb, e.g. {"add": "backend"} or
+// {"remove": {"name": "API"}}. Exactly one field is set.
+type updateOp struct {
+	Add    any `json:"add,omitempty"`
+	Remove any `json:"remove,omitempty"`
 }
 
 // updateFields contains the fields for updating an issue.
@@ -51,6 +78,9 @@ type updateFields struct {
 	Priority    *priorityRef   `json:"priority,omitempty"`
 	Assignee    *assigneeField `json:"assignee,omitempty"`
 	Labels      *[]string      `json:"labels,omitempty"`
+	Components  *[]nameRef     `json:"components,omitempty"`
+	Versions    *[]nameRef     `json:"versions,omitempty"`
+	FixVersions *[]nameRef     `json:"fixVersions,omitempty"`
 	Parent      *parentField   `json:"parent,omitempty"`
 }
 
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// UserService implements jira4claude.UserService using the Jira REST API.
+type UserService struct {
+	client *Client
+}
+
+// Compile-time interface verification.
+var _ jira4claude.UserService = (*UserService)(nil)
+
+// NewUserService creates a new UserService using the provided HTTP client.
+func NewUserService(client *Client) *UserService {
+	return &UserService{client: client}
+}
+
+// searchUserResponse represents a user in the Jira user search API.
+type searchUserResponse struct {
+	userResponse
+	AccountType string `json:"accountType"`
+	Active      bool   `json:"active"`
+}
+
+// Me returns the authenticated user.
+func (s *UserService) Me(ctx context.Context) (*jira4claude.User, error) {
+	var resp userResponse
+	if err := getJSON(ctx, s.client, "/rest/api/3/myself", &resp); err != nil {
+		return nil, err
+	}
+	return mapUser(&resp), nil
+}
+
+// Search returns active users whose display name or email matches query.
+func (s *UserService) Search(ctx context.Context, query string) ([]*jira4claude.User, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	return s.search(ctx, "/rest/api/3/user/search?"+params.Encode())
+}
+
+// Assignable returns active users matching query who can be assigned the issue.
+func (s *UserService) Assignable(ctx context.Context, key, query string) ([]*jira4claude.User, error) {
+	params := url.Values{}
+	params.Set("issueKey", key)
+	params.Set("query", query)
+	return s.search(ctx, "/rest/api/3/user/assignable/search?"+params.Encode())
+}
+
+// search fetches users from a user search endpoint, skipping inactive
+// accounts and app users, which cannot be assigned issues.
+func (s *UserService) search(ctx context.Context, path string) ([]*jira4claude.User, error) {
+	var resp []searchUserResponse
+	if err := getJSON(ctx, s.client, path, &resp); err != nil {
+		return nil, err
+	}
+
+	users := make([]*jira4claude.User, 0, len(resp))
+	for i := range resp {
+		if !resp[i].Active || resp[i].AccountType == "app" {
+			continue
+		}
+		users = append(users, mapUser(&resp[i].userResponse))
+	}
+	return users, nil
+}
@@ -0,0 +1,104 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	jirahttp "github.com/fwojciec/jira4claude/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_Me(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the authenticated user", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rest/api/3/myself" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"accountId": "acc-1", "displayName": "Jane Doe", "emailAddress": "jane@example.com"}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewUserService(client)
+
+		user, err := svc.Me(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, &jira4claude.User{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"}, user)
+	})
+}
+
+func TestUserService_Search(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns active human users", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedQuery string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rest/api/3/user/search" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			receivedQuery = r.URL.Query().Get("query")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"accountId": "acc-1", "displayName": "Jane Doe", "accountType": "atlassian", "active": true},
+				{"accountId": "acc-2", "displayName": "Jane Old", "accountType": "atlassian", "active": false},
+				{"accountId": "acc-3", "displayName": "Jane Bot", "accountType": "app", "active": true}
+			]`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewUserService(client)
+
+		users, err := svc.Search(context.Background(), "jane & co")
+
+		require.NoError(t, err)
+		assert.Equal(t, "jane & co", receivedQuery)
+		require.Len(t, users, 1)
+		assert.Equal(t, "acc-1", users[0].AccountID)
+	})
+}
+
+func TestUserService_Assignable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("searches users assignable to the issue", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedKey, receivedQuery string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rest/api/3/user/assignable/search" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			receivedKey = r.URL.Query().Get("issueKey")
+			receivedQuery = r.URL.Query().Get("query")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"accountId": "acc-1", "displayName": "Jane Doe", "accountType": "atlassian", "active": true}]`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewUserService(client)
+
+		users, err := svc.Assignable(context.Background(), "TEST-1", "jane")
+
+		require.NoError(t, err)
+		assert.Equal(t, "TEST-1", receivedKey)
+		assert.Equal(t, "jane", receivedQuery)
+		require.Len(t, users, 1)
+	})
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// VersionService implements jira4claude.VersionService using the Jira REST API.
+type VersionService struct {
+	client *Client
+}
+
+// Compile-time interface verification.
+var _ jira4claude.VersionService = (*VersionService)(nil)
+
+// NewVersionService creates a new VersionService using the provided HTTP client.
+func NewVersionService(client *Client) *VersionService {
+	return &VersionService{client: client}
+}
+
+// versionResponse represents a version in the Jira API.
+type versionResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	ReleaseDate string `json:"releaseDate"`
+}
+
+// createVersionRequest represents the request body for creating a version.
+type createVersionRequest struct {
+	Project     string `json:"project"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Released    bool   `json:"released,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+}
+
+// releaseVersionRequest represents the request body for releasing a version.
+type releaseVersionRequest struct {
+	Released    bool   `json:"released"`
+	ReleaseDate string `json:"releaseDate"`
+}
+
+// List returns the versions of a project.
+func (s *VersionService) List(ctx context.Context, project string) ([]*jira4claude.Version, error) {
+	var resp []versionResponse
+	if err := getJSON(ctx, s.client, "/rest/api/3/project/"+url.PathEscape(project)+"/versions", &resp); err != nil {
+		return nil, err
+	}
+
+	versions := make([]*jira4claude.Version, len(resp))
+	for i := range resp {
+		versions[i] = mapVersion(&resp[i], project)
+	}
+	return versions, nil
+}
+
+// Create creates a new version and returns it with ID populated.
+func (s *VersionService) Create(ctx context.Context, version *jira4claude.Version) (*jira4claude.Version, error) {
+	reqBody := createVersionRequest{
+		Project:     version.Project,
+		Name:        version.Name,
+		Description: version.Description,
+		Released:    version.Released,
+		ReleaseDate: version.ReleaseDate,
+	}
+
+	req, err := s.client.NewJSONRequest(ctx, http.MethodPost, "/rest/api/3/version", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := s.client.DoRequest(req, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return parseVersionResponse(respBody, version.Project)
+}
+
+// Release marks the named version as released on date.
+func (s *VersionService) Release(ctx context.Context, project, name, date string) (*jira4claude.Version, error) {
+	versions, err := s.List(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	version, err := findVersion(versions, project, name)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := releaseVersionRequest{Released: true, ReleaseDate: date}
+	req, err := s.client.NewJSONRequest(ctx, http.MethodPut, "/rest/api/3/version/"+url.PathEscape(version.ID), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := s.client.DoRequest(req, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return parseVersionResponse(respBody, project)
+}
+
+// findVersion returns the version with the given name, preferring an exact
+// match over a case-insensitive one.
+func findVersion(versions []*jira4claude.Version, project, name string) (*jira4claude.Version, error) {
+	var folded *jira4claude.Version
+	names := make([]string, len(versions))
+	for i, v := range versions {
+		if v.Name == name {
+			return v, nil
+		}
+		if folded == nil && strings.EqualFold(v.Name, name) {
+			folded = v
+		}
+		names[i] = strconv.Quote(v.Name)
+	}
+	if folded != nil {
+		return folded, nil
+	}
+
+	msg := "version " + strconv.Quote(name) + " not found in project " + project
+	if len(names) > 0 {
+		msg += "; versions: " + strings.Join(names, ", ")
+	}
+	return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+}
+
+// parseVersionResponse parses a single version from a Jira API response.
+func parseVersionResponse(body []byte, project string) (*jira4claude.Version, error) {
+	var resp versionResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to parse response",
+			Inner:   err,
+		}
+	}
+	return mapVersion(&resp, project), nil
+}
+
+// mapVersion converts a versionResponse to a domain Version.
+func mapVersion(resp *versionResponse, project string) *jira4claude.Version {
+	return &jira4claude.Version{
+		ID:          resp.ID,
+		Project:     project,
+		Name:        resp.Name,
+		Description: resp.Description,
+		Released:    resp.Released,
+		Archived:    resp.Archived,
+		ReleaseDate: resp.ReleaseDate,
+	}
+}
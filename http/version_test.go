@@ -0,0 +1,162 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	jirahttp "github.com/fwojciec/jira4claude/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionService_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns project versions", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/project/TEST/versions" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"id": "10", "name": "1.0", "released": true, "releaseDate": "2024-05-01"},
+				{"id": "11", "name": "1.1", "description": "Next", "archived": true}
+			]`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewVersionService(client)
+
+		versions, err := svc.List(context.Background(), "TEST")
+
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, &jira4claude.Version{
+			ID: "10", Project: "TEST", Name: "1.0", Released: true, ReleaseDate: "2024-05-01",
+		}, versions[0])
+		assert.Equal(t, "Next", versions[1].Description)
+		assert.True(t, versions[1].Archived)
+	})
+
+	t.Run("returns not found error for missing project", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages": ["No project could be found with key 'NOPE'."], "errors": {}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewVersionService(client)
+
+		_, err := svc.List(context.Background(), "NOPE")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestVersionService_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates a version in the project", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/version" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "12", "name": "1.2", "description": "Spring", "releaseDate": "2024-06-01"}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewVersionService(client)
+
+		created, err := svc.Create(context.Background(), &jira4claude.Version{
+			Project:     "TEST",
+			Name:        "1.2",
+			Description: "Spring",
+			ReleaseDate: "2024-06-01",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"project":     "TEST",
+			"name":        "1.2",
+			"description": "Spring",
+			"releaseDate": "2024-06-01",
+		}, receivedRequest)
+		assert.Equal(t, "12", created.ID)
+		assert.Equal(t, "TEST", created.Project)
+	})
+}
+
+func TestVersionService_Release(t *testing.T) {
+	t.Parallel()
+
+	t.Run("releases the version matching the name", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedRequest map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/project/TEST/versions":
+				_, _ = w.Write([]byte(`[{"id": "10", "name": "1.0"}, {"id": "11", "name": "v1.1"}]`))
+			case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/version/11":
+				_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+				_, _ = w.Write([]byte(`{"id": "11", "name": "v1.1", "released": true, "releaseDate": "2024-07-01"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewVersionService(client)
+
+		released, err := svc.Release(context.Background(), "TEST", "V1.1", "2024-07-01")
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"released": true, "releaseDate": "2024-07-01"}, receivedRequest)
+		assert.True(t, released.Released)
+		assert.Equal(t, "v1.1", released.Name)
+	})
+
+	t.Run("returns not found error listing versions", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Error("unexpected request")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id": "10", "name": "1.0"}]`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewVersionService(client)
+
+		_, err := svc.Release(context.Background(), "TEST", "2.0", "2024-07-01")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, jira4claude.ErrorMessage(err), `"1.0"`)
+	})
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"cmp"
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// WorkflowService implements jira4claude.WorkflowService using the Jira REST API.
+// Reading workflow schemes requires Jira administrator permission.
+type WorkflowService struct {
+	client *Client
+}
+
+// Compile-time interface verification.
+var _ jira4claude.WorkflowService = (*WorkflowService)(nil)
+
+// NewWorkflowService creates a new WorkflowService using the provided HTTP client.
+func NewWorkflowService(client *Client) *WorkflowService {
+	return &WorkflowService{client: client}
+}
+
+// workflowIssueResponse holds the issue fields that select its workflow.
+type workflowIssueResponse struct {
+	Fields struct {
+		Project   struct{ ID string } `json:"project"`
+		IssueType struct{ ID string } `json:"issuetype"`
+	} `json:"fields"`
+}
+
+// workflowSchemeResponse represents the workflow schemes of a project.
+type workflowSchemeResponse struct {
+	Values []workflowSchemeProjects `json:"values"`
+}
+
+// workflowSchemeProjects pairs a workflow scheme with the projects using it.
+type workflowSchemeProjects struct {
+	ProjectIDs     []string `json:"projectIds"`
+	WorkflowScheme struct {
+		DefaultWorkflow   string            `json:"defaultWorkflow"`
+		IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+	} `json:"workflowScheme"`
+}
+
+// workflowSearchResponse represents a workflow search result.
+type workflowSearchResponse struct {
+	Values []struct {
+		ID struct {
+			Name string `json:"name"`
+		} `json:"id"`
+		Transitions []struct {
+			ID   string   `json:"id"`
+			Name string   `json:"name"`
+			From []string `json:"from"`
+			To   string   `json:"to"`
+			Type string   `json:"type"`
+		} `json:"transitions"`
+		Statuses []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"statuses"`
+	} `json:"values"`
+}
+
+// Workflow returns the workflow that applies to the issue, found through the
+// workflow scheme of its project.
+func (s *WorkflowService) Workflow(ctx context.Context, key string) (*jira4claude.Workflow, error) {
+	var issue workflowIssueResponse
+	if err := getJSON(ctx, s.client, issuePath(key)+"?fields=project,issuetype", &issue); err != nil {
+		return nil, err
+	}
+
+	var schemes workflowSchemeResponse
+	if err := getJSON(ctx, s.client, "/rest/api/3/workflowscheme/project?projectId="+url.QueryEscape(issue.Fields.Project.ID), &schemes); err != nil {
+		return nil, err
+	}
+	// Pick the scheme listing the issue's project rather than trusting the order.
+	i := slices.IndexFunc(schemes.Values, func(v workflowSchemeProjects) bool {
+		return slices.Contains(v.ProjectIDs, issue.Fields.Project.ID)
+	})
+	if i < 0 {
+		return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "no workflow scheme found for " + key}
+	}
+	scheme := schemes.Values[i].WorkflowScheme
+	name := cmp.Or(scheme.IssueTypeMappings[issue.Fields.IssueType.ID], scheme.DefaultWorkflow)
+
+	params := url.Values{}
+	params.Set("workflowName", name)
+	params.Set("expand", "transitions,statuses")
+	var search workflowSearchResponse
+	if err := getJSON(ctx, s.client, "/rest/api/3/workflow/search?"+params.Encode(), &search); err != nil {
+		return nil, err
+	}
+	if len(search.Values) == 0 {
+		return nil, &jira4claude.Error{Code: jira4claude.ENotFound, Message: "workflow " + strconv.Quote(name) + " not found"}
+	}
+	resp := search.Values[0]
+
+	statuses := make(map[string]string, len(resp.Statuses))
+	for _, st := range resp.Statuses {
+		statuses[st.ID] = st.Name
+	}
+
+	workflow := &jira4claude.Workflow{Name: resp.ID.Name}
+	for _, t := range resp.Transitions {
+		// The initial transition creates the issue and cannot be taken later
+		if t.Type == "initial" {
+			continue
+		}
+		from := make([]string, len(t.From))
+		for i, id := range t.From {
+			from[i] = statuses[id]
+		}
+		workflow.Transitions = append(workflow.Transitions, &jira4claude.WorkflowTransition{
+			ID:   t.ID,
+			Name: t.Name,
+			From: from,
+			To:   statuses[t.To],
+		})
+	}
+	return workflow, nil
+}
@@ -0,0 +1,118 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	jirahttp "github.com/fwojciec/jira4claude/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowService_Workflow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads the workflow mapped to the issue type", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/rest/api/3/issue/TEST-1":
+				_, _ = w.Write([]byte(`{"fields": {"project": {"id": "100"}, "issuetype": {"id": "7"}}}`))
+			case "/rest/api/3/workflowscheme/project":
+				assert.Equal(t, "100", r.URL.Query().Get("projectId"))
+				_, _ = w.Write([]byte(`{"values": [
+					{"projectIds": ["200"], "workflowScheme": {"defaultWorkflow": "Other workflow"}},
+					{"projectIds": ["100"], "workflowScheme": {
+						"defaultWorkflow": "jira",
+						"issueTypeMappings": {"7": "Bug workflow"}
+					}}
+				]}`))
+			case "/rest/api/3/workflow/search":
+				assert.Equal(t, "Bug workflow", r.URL.Query().Get("workflowName"))
+				_, _ = w.Write([]byte(`{"values": [{
+					"id": {"name": "Bug workflow"},
+					"transitions": [
+						{"id": "1", "name": "Create", "from": [], "to": "10", "type": "initial"},
+						{"id": "11", "name": "Start", "from": ["10"], "to": "20", "type": "directed"},
+						{"id": "21", "name": "Close", "from": [], "to": "30", "type": "global"}
+					],
+					"statuses": [
+						{"id": "10", "name": "Open"},
+						{"id": "20", "name": "Fixing"},
+						{"id": "30", "name": "Closed"}
+					]
+				}]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewWorkflowService(client)
+
+		workflow, err := svc.Workflow(context.Background(), "TEST-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, &jira4claude.Workflow{
+			Name: "Bug workflow",
+			Transitions: []*jira4claude.WorkflowTransition{
+				{ID: "11", Name: "Start", From: []string{"Open"}, To: "Fixing"},
+				{ID: "21", Name: "Close", From: []string{}, To: "Closed"},
+			},
+		}, workflow)
+	})
+
+	t.Run("returns not found when no scheme lists the project", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/rest/api/3/issue/TEST-1":
+				_, _ = w.Write([]byte(`{"fields": {"project": {"id": "100"}, "issuetype": {"id": "7"}}}`))
+			case "/rest/api/3/workflowscheme/project":
+				_, _ = w.Write([]byte(`{"values": [{"projectIds": ["200"], "workflowScheme": {"defaultWorkflow": "Other workflow"}}]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewWorkflowService(client)
+
+		_, err := svc.Workflow(context.Background(), "TEST-1")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns forbidden error without admin permission", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/rest/api/3/issue/TEST-1" {
+				_, _ = w.Write([]byte(`{"fields": {"project": {"id": "100"}, "issuetype": {"id": "7"}}}`))
+				return
+			}
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorMessages": ["You are not authorized to perform this action."]}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(t, server.URL, "user@example.com", "api-token")
+		svc := jirahttp.NewWorkflowService(client)
+
+		_, err := svc.Workflow(context.Background(), "TEST-1")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EForbidden, jira4claude.ErrorCode(err))
+	})
+}
@@ -13,6 +13,14 @@ const (
 	StatusWontDo     = "Won't Do"
 )
 
+// Status category keys. Every workflow status belongs to one of these,
+// whatever its name.
+const (
+	StatusCategoryToDo       = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
 // LinkInwardBlockedBy is the inward description for a "Blocks" link type.
 // When issue A blocks issue B, issue B has an inward link with this description.
 const LinkInwardBlockedBy = "is blocked by"
@@ -26,8 +34,26 @@ type User struct {
 
 // Transition represents a workflow transition available for an issue.
 type Transition struct {
-	ID   string
-	Name string
+	ID       string
+	Name     string
+	To       string // Name of the status the transition leads to
+	Category string // Status category key of the target status, e.g. StatusCategoryDone
+	Fields   []*TransitionField
+}
+
+// TransitionField describes a field on a transition screen.
+type TransitionField struct {
+	ID            string // Field ID, e.g. "resolution" or "customfield_10010"
+	Name          string
+	Required      bool
+	AllowedValues []string // Names of the allowed values; nil for free-form fields
+}
+
+// TransitionInput holds values set on the transition screen.
+type TransitionInput struct {
+	Resolution string         // Resolution name, e.g. "Done"; empty leaves it unset
+	Fields     map[string]any // Field values by field ID, in the shape the Jira API expects
+	Comment    ADF            // Comment added with the transition; nil for none
 }
 
 // IssueLinkType represents the type of relationship between linked issues.
@@ -53,6 +79,15 @@ type LinkedIssue struct {
 	Type    string
 }
 
+// RemoteLink represents a link from an issue to a web resource, such as a
+// pull request, a CI run or a design document.
+type RemoteLink struct {
+	ID           string
+	URL          string
+	Title        string
+	Relationship string // e.g., "pull request"; empty for a plain web link
+}
+
 // Comment represents a comment on an issue.
 type Comment struct {
 	ID      string
@@ -63,22 +98,26 @@ type Comment struct {
 
 // Issue represents a Jira issue with its core fields.
 type Issue struct {
-	Key         string
-	Project     string
-	Summary     string
-	Description ADF // ADF document; conversion to markdown happens at CLI boundary
-	Status      string
-	Type        string
-	Priority    string
-	Assignee    *User
-	Reporter    *User
-	Labels      []string
-	Links       []*IssueLink
-	Comments    []*Comment     // Comments on the issue
-	Parent      *LinkedIssue   // Parent issue (for subtasks or epic children); nil otherwise
-	Subtasks    []*LinkedIssue // Subtasks or epic children; nil if none
-	Created     time.Time
-	Updated     time.Time
+	Key             string
+	Project         string
+	Summary         string
+	Description     ADF // ADF document; conversion to markdown happens at CLI boundary
+	Status          string
+	Type            string
+	Priority        string
+	Assignee        *User
+	Reporter        *User
+	Labels          []string
+	Components      []string // Component names
+	AffectsVersions []string // Affected version names
+	FixVersions     []string // Fix version names
+	Links           []*IssueLink
+	RemoteLinks     []*RemoteLink  // Web links; Get leaves this nil, see IssueService.RemoteLinks
+	Comments        []*Comment     // Comments on the issue
+	Parent          *LinkedIssue   // Parent issue (for subtasks or epic children); nil otherwise
+	Subtasks        []*LinkedIssue // Subtasks or epic children; nil if none
+	Created         time.Time
+	Updated         time.Time
 }
 
 // IssueFilter specifies criteria for listing issues.
@@ -86,11 +125,13 @@ type Issue struct {
 // Otherwise, non-empty fields are combined with AND logic.
 type IssueFilter struct {
 	Project       string
-	Status        string // Exact match: status = "X"
-	ExcludeStatus string // Exclusion: status != "X" (ignored if Status is set)
-	Assignee      string
+	Status        string   // Exact match: status = "X"
+	ExcludeStatus string   // Exclusion: status != "X" (ignored if Status is set)
+	Assignee      string   // Account ID, or UserMe for the authenticated user
 	Parent        string   // Filter by parent issue key (for subtasks)
 	Labels        []string // Issues must have ALL specified labels
+	Component     string   // Component name: component = "X"
+	FixVersion    string   // Version name: fixVersion = "X"
 	OrderBy       string   // e.g., "created DESC"
 	JQL           string   // Raw JQL query; overrides other fields if set
 	Limit         int      // Maximum number of issues to return
@@ -99,15 +140,28 @@ type IssueFilter struct {
 // IssueUpdate specifies fields to update on an issue.
 // Pointer fields: nil means no change, non-nil means set to that value.
 // For Assignee: empty string means unassign.
-// For Labels: nil means no change, empty slice means clear all labels.
+// For Labels, Components, AffectsVersions and FixVersions: nil means no change,
+// empty slice means clear all values.
 // For Parent: nil means no change, empty string means clear, non-empty means set.
+// The Add and Remove fields change a list incrementally, leaving other values
+// in place; they cannot be combined with replacing the same list.
 type IssueUpdate struct {
-	Summary     *string
-	Description *ADF // ADF document; conversion from markdown happens at CLI boundary
-	Priority    *string
-	Assignee    *string
-	Labels      *[]string
-	Parent      *string // nil = no change, "" = clear parent, "KEY" = set parent
+	Summary         *string
+	Description     *ADF // ADF document; conversion from markdown happens at CLI boundary
+	Priority        *string
+	Assignee        *string
+	Labels          *[]string
+	Components      *[]string // Component names
+	AffectsVersions *[]string // Affected version names
+	FixVersions     *[]string // Fix version names
+	Parent          *string   // nil = no change, "" = clear parent, "KEY" = set parent
+
+	AddLabels         []string
+	RemoveLabels      []string
+	AddComponents     []string // Component names
+	RemoveComponents  []string // Component names
+	AddFixVersions    []string // Version names
+	RemoveFixVersions []string // Version names
 }
 
 // IssueService defines operations for managing Jira issues.
@@ -132,15 +186,32 @@ type IssueService interface {
 	// The body is an ADF document; conversion from markdown happens at CLI boundary.
 	AddComment(ctx context.Context, key string, body ADF) (*Comment, error)
 
-	// Transitions returns available workflow transitions for an issue.
+	// Transitions returns available workflow transitions for an issue,
+	// including the fields on each transition screen.
 	Transitions(ctx context.Context, key string) ([]*Transition, error)
 
-	// Transition moves an issue to a new status.
-	Transition(ctx context.Context, key, transitionID string) error
+	// Transition moves an issue to a new status, setting the input values
+	// on the transition screen.
+	Transition(ctx context.Context, key, transitionID string, input TransitionInput) error
 
 	// Assign assigns an issue to a user by account ID.
 	Assign(ctx context.Context, key, accountID string) error
 
+	// Watchers returns the users watching an issue.
+	Watchers(ctx context.Context, key string) ([]*User, error)
+
+	// AddWatcher adds a user to an issue's watchers by account ID.
+	AddWatcher(ctx context.Context, key, accountID string) error
+
+	// RemoveWatcher removes a user from an issue's watchers by account ID.
+	RemoveWatcher(ctx context.Context, key, accountID string) error
+
+	// Vote adds the authenticated user's vote to an issue.
+	Vote(ctx context.Context, key string) error
+
+	// Unvote removes the authenticated user's vote from an issue.
+	Unvote(ctx context.Context, key string) error
+
 	// Link creates a link between two issues.
 	// The linkType is the name of the link type (e.g., "Blocks").
 	// The inwardKey is the issue that has the relationship, and the outwardKey
@@ -148,7 +219,15 @@ type IssueService interface {
 	// means that issue A blocks issue B (A is the blocker, B is blocked).
 	Link(ctx context.Context, inwardKey, linkType, outwardKey string) error
 
-	// Unlink removes a link between two issues.
-	// It finds and removes any link connecting the two issues.
-	Unlink(ctx context.Context, key1, key2 string) error
+	// DeleteLink deletes an issue link by its ID (see IssueLink.ID).
+	DeleteLink(ctx context.Context, linkID string) error
+
+	// LinkTypes returns the issue link types configured on the site.
+	LinkTypes(ctx context.Context) ([]*IssueLinkType, error)
+
+	// RemoteLinks returns the web links of an issue.
+	RemoteLinks(ctx context.Context, key string) ([]*RemoteLink, error)
+
+	// AddRemoteLink adds a web link to an issue and returns it with ID populated.
+	AddRemoteLink(ctx context.Context, key string, link *RemoteLink) (*RemoteLink, error)
 }
@@ -59,6 +59,42 @@ func (p *Printer) Transitions(_ string, ts []*jira4claude.Transition) {
 	result := make([]map[string]any, len(ts))
 	for i, t := range ts {
 		result[i] = map[string]any{"id": t.ID, "name": t.Name}
+		if t.To != "" {
+			result[i]["to"] = t.To
+		}
+		if t.Category != "" {
+			result[i]["statusCategory"] = t.Category
+		}
+		var required []map[string]any
+		for _, f := range t.Fields {
+			if !f.Required {
+				continue
+			}
+			field := map[string]any{"id": f.ID, "name": f.Name}
+			if len(f.AllowedValues) > 0 {
+				field["allowedValues"] = f.AllowedValues
+			}
+			required = append(required, field)
+		}
+		if len(required) > 0 {
+			result[i]["requiredFields"] = required
+		}
+	}
+	p.encode(result)
+}
+
+// Drafts prints issues that would be created as JSON array.
+func (p *Printer) Drafts(views []jira4claude.IssueView) {
+	result := make([]map[string]any, len(views))
+	for i, v := range views {
+		draft := map[string]any{
+			"summary": v.Summary,
+			"type":    v.Type,
+		}
+		if v.Description != "" {
+			draft["description"] = v.Description
+		}
+		result[i] = draft
 	}
 	p.encode(result)
 }
@@ -68,6 +104,108 @@ func (p *Printer) Links(_ string, links []jira4claude.RelatedIssueView) {
 	p.encode(links)
 }
 
+// LinkTypes prints link types as JSON array.
+func (p *Printer) LinkTypes(types []*jira4claude.IssueLinkType) {
+	result := make([]map[string]any, len(types))
+	for i, t := range types {
+		result[i] = map[string]any{
+			"name":    t.Name,
+			"outward": t.Outward,
+			"inward":  t.Inward,
+		}
+	}
+	p.encode(result)
+}
+
+// Users prints users as JSON array.
+func (p *Printer) Users(users []*jira4claude.User) {
+	result := make([]map[string]any, len(users))
+	for i, u := range users {
+		result[i] = map[string]any{
+			"accountId":   u.AccountID,
+			"displayName": u.DisplayName,
+		}
+		if u.Email != "" {
+			result[i]["email"] = u.Email
+		}
+	}
+	p.encode(result)
+}
+
+// Versions prints project versions as JSON array.
+func (p *Printer) Versions(versions []*jira4claude.Version) {
+	result := make([]map[string]any, len(versions))
+	for i, v := range versions {
+		result[i] = map[string]any{
+			"id":       v.ID,
+			"project":  v.Project,
+			"name":     v.Name,
+			"released": v.Released,
+			"archived": v.Archived,
+		}
+		if v.Description != "" {
+			result[i]["description"] = v.Description
+		}
+		if v.ReleaseDate != "" {
+			result[i]["releaseDate"] = v.ReleaseDate
+		}
+	}
+	p.encode(result)
+}
+
+// Profiles prints config profiles as JSON array.
+func (p *Printer) Profiles(profiles []*jira4claude.Profile) {
+	result := make([]map[string]any, len(profiles))
+	for i, pr := range profiles {
+		result[i] = map[string]any{
+			"name":    pr.Name,
+			"server":  pr.Server,
+			"project": pr.Project,
+			"default": pr.Default,
+			"active":  pr.Active,
+		}
+		if pr.Netrc != "" {
+			result[i]["netrc"] = pr.Netrc
+		}
+		if pr.Output != "" {
+			result[i]["output"] = pr.Output
+		}
+	}
+	p.encode(result)
+}
+
+// Settings prints config settings as JSON array.
+// Origins are included only when set.
+func (p *Printer) Settings(settings []*jira4claude.ConfigSetting) {
+	result := make([]map[string]any, len(settings))
+	for i, s := range settings {
+		result[i] = map[string]any{"key": s.Key, "value": s.Value}
+		if s.Origin != "" {
+			result[i]["origin"] = s.Origin
+		}
+	}
+	p.encode(result)
+}
+
+// Checks prints diagnostic checks as JSON array.
+func (p *Printer) Checks(checks []*jira4claude.Check) {
+	result := make([]map[string]any, len(checks))
+	for i, c := range checks {
+		result[i] = map[string]any{
+			"name":    c.Name,
+			"ok":      c.Code == "" && !c.Skipped,
+			"message": c.Message,
+		}
+		if c.Code != "" {
+			result[i]["code"] = c.Code
+		}
+		if c.Skipped {
+			result[i]["skipped"] = true
+		}
+	}
+	p.encode(result)
+}
+
 // Success prints a success message as JSON.
 func (p *Printer) Success(msg string, keys ...string) {
 	result := map[string]any{
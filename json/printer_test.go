@@ -274,7 +274,10 @@ func TestPrinter_Transitions(t *testing.T) {
 
 	transitions := []*jira4claude.Transition{
 		{ID: "1", Name: "In Progress"},
-		{ID: "2", Name: "Done"},
+		{ID: "2", Name: "Done", To: "Closed", Category: jira4claude.StatusCategoryDone, Fields: []*jira4claude.TransitionField{
+			{ID: "comment", Name: "Comment"},
+			{ID: "resolution", Name: "Resolution", Required: true, AllowedValues: []string{"Done"}},
+		}},
 	}
 
 	p.Transitions("TEST-123", transitions)
@@ -285,6 +288,154 @@ func TestPrinter_Transitions(t *testing.T) {
 	assert.Len(t, result, 2)
 	assert.Equal(t, "1", result[0]["id"])
 	assert.Equal(t, "In Progress", result[0]["name"])
+	assert.NotContains(t, result[0], "requiredFields")
+	assert.Equal(t, "Closed", result[1]["to"])
+	assert.Equal(t, "done", result[1]["statusCategory"])
+	assert.Equal(t, []any{
+		map[string]any{"id": "resolution", "name": "Resolution", "allowedValues": []any{"Done"}},
+	}, result[1]["requiredFields"])
+}
+
+func TestPrinter_Users(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Users([]*jira4claude.User{
+		{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+		{AccountID: "acc-2", DisplayName: "John Roe"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "acc-1", result[0]["accountId"])
+	assert.Equal(t, "jane@example.com", result[0]["email"])
+	assert.NotContains(t, result[1], "email")
+}
+
+func TestPrinter_Drafts(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Drafts([]jira4claude.IssueView{
+		{Summary: "Add endpoint", Type: "Sub-task", Description: "- detail"},
+		{Summary: "Write docs", Type: "Sub-task"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"summary": "Add endpoint", "type": "Sub-task", "description": "- detail"},
+		{"summary": "Write docs", "type": "Sub-task"},
+	}, result)
+}
+
+func TestPrinter_LinkTypes(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.LinkTypes([]*jira4claude.IssueLinkType{{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "Blocks", "outward": "blocks", "inward": "is blocked by"}}, result)
+}
+
+func TestPrinter_Versions(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Versions([]*jira4claude.Version{
+		{ID: "10", Project: "TEST", Name: "1.0", Released: true, ReleaseDate: "2024-05-01"},
+		{ID: "11", Project: "TEST", Name: "1.1"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "1.0", result[0]["name"])
+	assert.Equal(t, true, result[0]["released"])
+	assert.Equal(t, "2024-05-01", result[0]["releaseDate"])
+	assert.Equal(t, false, result[1]["released"])
+	assert.NotContains(t, result[1], "releaseDate")
+}
+
+func TestPrinter_Profiles(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Profiles([]*jira4claude.Profile{
+		{Name: "ops", Server: "https://one.atlassian.net", Project: "OPS", Default: true, Active: true},
+		{Name: "web", Server: "https://two.atlassian.net", Project: "WEB", Output: "json"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "ops", result[0]["name"])
+	assert.Equal(t, true, result[0]["active"])
+	assert.Equal(t, true, result[0]["default"])
+	assert.NotContains(t, result[0], "output")
+	assert.Equal(t, "json", result[1]["output"])
+}
+
+func TestPrinter_Settings(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Settings([]*jira4claude.ConfigSetting{
+		{Key: "server", Value: "https://example.atlassian.net", Origin: "/home/me/.jira4claude.yaml"},
+		{Key: "project", Value: "TEST"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "server", result[0]["key"])
+	assert.Equal(t, "/home/me/.jira4claude.yaml", result[0]["origin"])
+	assert.Equal(t, "TEST", result[1]["value"])
+	assert.NotContains(t, result[1], "origin")
+}
+
+func TestPrinter_Checks(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := jsonpkg.NewPrinter(&out)
+
+	p.Checks([]*jira4claude.Check{
+		{Name: "credentials", Message: "netrc entry for example.atlassian.net"},
+		{Name: "auth", Code: jira4claude.EUnauthorized, Message: "Client must be authenticated"},
+		{Name: "project", Skipped: true, Message: "skipped"},
+	})
+
+	var result []map[string]any
+	err := json.Unmarshal(out.Bytes(), &result)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Equal(t, true, result[0]["ok"])
+	assert.NotContains(t, result[0], "code")
+	assert.Equal(t, false, result[1]["ok"])
+	assert.Equal(t, "unauthorized", result[1]["code"])
+	assert.Equal(t, true, result[2]["skipped"])
 }
 
 func TestPrinter_Links(t *testing.T) {
@@ -0,0 +1,58 @@
+package jira4claude
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ResolveLinkType finds the link type that phrase refers to: a type name such
+// as "Blocks", or one of its descriptions such as "blocks" or "is blocked by",
+// compared case-insensitively. Names win over outward descriptions, which win
+// over inward ones. Reversed reports that phrase is an inward description, so
+// the issues it relates must be swapped to read in the outward direction.
+func ResolveLinkType(types []*IssueLinkType, phrase string) (linkType *IssueLinkType, reversed bool, err error) {
+	tiers := []struct {
+		desc     func(*IssueLinkType) string
+		reversed bool
+	}{
+		{func(t *IssueLinkType) string { return t.Name }, false},
+		{func(t *IssueLinkType) string { return t.Outward }, false},
+		{func(t *IssueLinkType) string { return t.Inward }, true},
+	}
+	for _, tier := range tiers {
+		var matches []*IssueLinkType
+		for _, t := range types {
+			if strings.EqualFold(tier.desc(t), phrase) {
+				matches = append(matches, t)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], tier.reversed, nil
+		}
+		return nil, false, &Error{
+			Code:    EConflict,
+			Message: "link type " + strconv.Quote(phrase) + " is ambiguous; matches: " + describeLinkTypes(matches) + "; use the type name",
+		}
+	}
+	return nil, false, &Error{
+		Code:    EValidation,
+		Message: "unknown link type " + strconv.Quote(phrase) + "; valid types: " + describeLinkTypes(types),
+	}
+}
+
+// describeLinkTypes lists link types with their descriptions, e.g.
+// "Blocks (blocks / is blocked by), Relates (relates to)".
+func describeLinkTypes(types []*IssueLinkType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name + " (" + t.Outward
+		if t.Inward != t.Outward {
+			names[i] += " / " + t.Inward
+		}
+		names[i] += ")"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,81 @@
+package jira4claude_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLinkType(t *testing.T) {
+	t.Parallel()
+
+	blocks := &jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}
+	relates := &jira4claude.IssueLinkType{Name: "Relates", Outward: "relates to", Inward: "relates to"}
+	types := []*jira4claude.IssueLinkType{blocks, relates}
+
+	t.Run("matches type names case-insensitively", func(t *testing.T) {
+		t.Parallel()
+
+		linkType, reversed, err := jira4claude.ResolveLinkType(types, "BLOCKS")
+
+		require.NoError(t, err)
+		assert.Same(t, blocks, linkType)
+		assert.False(t, reversed)
+	})
+
+	t.Run("reverses inward descriptions", func(t *testing.T) {
+		t.Parallel()
+
+		linkType, reversed, err := jira4claude.ResolveLinkType(types, "Is Blocked By")
+
+		require.NoError(t, err)
+		assert.Same(t, blocks, linkType)
+		assert.True(t, reversed)
+	})
+
+	t.Run("does not reverse symmetric types", func(t *testing.T) {
+		t.Parallel()
+
+		linkType, reversed, err := jira4claude.ResolveLinkType(types, "relates to")
+
+		require.NoError(t, err)
+		assert.Same(t, relates, linkType)
+		assert.False(t, reversed)
+	})
+
+	t.Run("prefers names over descriptions", func(t *testing.T) {
+		t.Parallel()
+
+		cloners := &jira4claude.IssueLinkType{Name: "Cloners", Outward: "clones", Inward: "is cloned by"}
+		clones := &jira4claude.IssueLinkType{Name: "Clones", Outward: "copies", Inward: "is copied by"}
+
+		linkType, _, err := jira4claude.ResolveLinkType([]*jira4claude.IssueLinkType{cloners, clones}, "clones")
+
+		require.NoError(t, err)
+		assert.Same(t, clones, linkType)
+	})
+
+	t.Run("reports unknown types with the valid ones", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := jira4claude.ResolveLinkType(types, "causes")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Equal(t, `unknown link type "causes"; valid types: Blocks (blocks / is blocked by), Relates (relates to)`, jira4claude.ErrorMessage(err))
+	})
+
+	t.Run("reports descriptions shared by several types as a conflict", func(t *testing.T) {
+		t.Parallel()
+
+		dependency := &jira4claude.IssueLinkType{Name: "Dependency", Outward: "is needed by", Inward: "is blocked by"}
+
+		_, _, err := jira4claude.ResolveLinkType([]*jira4claude.IssueLinkType{blocks, dependency}, "is blocked by")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EConflict, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "Dependency (is needed by / is blocked by)")
+	})
+}
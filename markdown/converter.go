@@ -2,27 +2,62 @@
 // and Atlassian Document Format (ADF) using the goldmark library.
 package markdown
 
-import "github.com/fwojciec/jira4claude"
+import (
+	"net/url"
+
+	"github.com/fwojciec/jira4claude"
+)
 
 // Compile-time interface verification.
 var _ jira4claude.Converter = (*Converter)(nil)
 
 // Converter implements jira4claude.Converter using goldmark for GFM parsing.
-type Converter struct{}
+type Converter struct {
+	preserveADF bool
+	jiraHost    string
+}
+
+// Option configures a Converter.
+type Option func(*Converter)
+
+// WithPreserveADF enables lossless preservation of ADF nodes and marks that markdown cannot
+// reproduce exactly. ToMarkdown embeds them as fenced ```adf blocks (or `adf:` code spans for inline nodes)
+// containing their JSON, and ToADF restores them verbatim, so view -> edit -> update
+// does not destroy content.
+func WithPreserveADF() Option {
+	return func(c *Converter) {
+		c.preserveADF = true
+	}
+}
+
+// WithServer sets the Jira server URL. Besides atlassian.net sites, URLs on
+// its host that stand alone on their line become smart links.
+func WithServer(serverURL string) Option {
+	return func(c *Converter) {
+		if u, err := url.Parse(serverURL); err == nil {
+			c.jiraHost = u.Host
+		}
+	}
+}
 
 // New creates a new Converter instance.
-func New() *Converter {
-	return &Converter{}
+func New(opts ...Option) *Converter {
+	c := &Converter{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // ToADF converts GitHub-flavored markdown to ADF.
+// Fenced ```adf blocks and `adf:` code spans are restored as the ADF nodes they embed.
 // Returns the ADF document and any warnings about skipped/unsupported content.
 func (c *Converter) ToADF(markdown string) (jira4claude.ADF, []string) {
-	return toADF(markdown)
+	return toADF(markdown, c.jiraHost)
 }
 
 // ToMarkdown converts ADF to GitHub-flavored markdown.
 // Returns the markdown string and any warnings about skipped/unsupported content.
 func (c *Converter) ToMarkdown(adfDoc jira4claude.ADF) (string, []string) {
-	return toMarkdown(adfDoc)
+	return toMarkdown(adfDoc, c.preserveADF, c.jiraHost)
 }
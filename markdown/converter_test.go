@@ -1,10 +1,17 @@
 package markdown_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strings"
 	"testing"
 
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/adf"
 	"github.com/fwojciec/jira4claude/markdown"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRoundTrip(t *testing.T) {
@@ -24,6 +31,16 @@ func TestRoundTrip(t *testing.T) {
 		{"ordered list", "1. First\n2. Second"},
 		{"link", "Visit [Google](https://google.com) for more."},
 		{"blockquote", "> This is a quote."},
+		{"bare URL", "See https://example.com for details."},
+		{"Jira smart link", "https://example.atlassian.net/browse/PROJ-1"},
+		{"nested bullet list", "- Parent\n  - Child\n    - Grandchild\n- Sibling"},
+		{"mixed nested lists", "1. First\n   - Bullet under first\n2. Second"},
+		{"ordered list with start number", "3. Third\n4. Fourth"},
+		{"code block in list item", "- Run this:\n\n  ```sh\n  make test\n  ```\n- Then this"},
+		{"multiple paragraphs in list item", "1. Step one\n\n   More detail\n2. Step two"},
+		{"hard line breaks in paragraph", "First line\\\nSecond line"},
+		{"formatted heading", "## **Bold** and `code` heading"},
+		{"blockquote with multiple paragraphs", "> First\n>\n> Second"},
 		{"multiple paragraphs", "First paragraph.\n\nSecond paragraph."},
 		{"combined bold and italic", "This is ***bold and italic*** text."},
 		{"complex document", `# Main Heading
@@ -60,3 +77,308 @@ This is a paragraph with **bold** and *italic* text.
 		})
 	}
 }
+
+func TestPreserveADF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("embeds unsupported block node as fenced adf block", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New(markdown.WithPreserveADF())
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{"type": "rule"},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "```adf\n{\n  \"type\": \"rule\"\n}\n```", result)
+	})
+
+	t.Run("skips unsupported nodes when preservation is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{"type": "rule"},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, result)
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "rule")
+	})
+
+	t.Run("round-trips unsupported block and inline nodes verbatim", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New(markdown.WithPreserveADF())
+		panel := map[string]any{
+			"type":  "panel",
+			"attrs": map[string]any{"panelType": "info"},
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Use ```code``` here"},
+					},
+				},
+			},
+		}
+		mention := map[string]any{
+			"type":  "mention",
+			"attrs": map[string]any{"id": "abc123", "text": "@Alice"},
+		}
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Ping "},
+						mention,
+					},
+				},
+				panel,
+			},
+		}
+
+		md, warnings := converter.ToMarkdown(adfDoc)
+		require.Empty(t, warnings)
+
+		result, warnings := converter.ToADF(md)
+		require.Empty(t, warnings)
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Ping "},
+						mention,
+					},
+				},
+				panel,
+			},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("round-trips nodes rendered as markdown text", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New(markdown.WithPreserveADF())
+		original := `{"type": "doc", "version": 1, "content": [
+			{"type": "paragraph", "content": [{"type": "inlineCard", "attrs": {"url": "https://example.atlassian.net/browse/PROJ-1"}}]},
+			{"type": "paragraph", "content": [{"type": "inlineCard", "attrs": {"url": "https://github.com/org/repo/pull/1"}}]},
+			{"type": "paragraph", "content": [
+				{"type": "text", "text": "See "},
+				{"type": "inlineCard", "attrs": {"url": "https://example.atlassian.net/browse/PROJ-2"}},
+				{"type": "text", "text": " for "},
+				{"type": "text", "text": "red", "marks": [{"type": "textColor", "attrs": {"color": "#ff5630"}}]},
+				{"type": "text", "text": " and "},
+				{"type": "text", "text": "underlined", "marks": [{"type": "underline"}, {"type": "strong"}]},
+				{"type": "text", "text": " and "},
+				{"type": "text", "text": "https://example.com", "marks": [{"type": "link", "attrs": {"href": "https://example.com"}}]}
+			]},
+			{"type": "blockCard", "attrs": {"url": "https://example.atlassian.net/browse/PROJ-3"}},
+			{"type": "paragraph", "content": [
+				{"type": "status", "attrs": {"text": "Done", "color": "green", "localId": "s1"}},
+				{"type": "text", "text": " on "},
+				{"type": "date", "attrs": {"timestamp": "1700000000000"}},
+				{"type": "text", "text": " "},
+				{"type": "emoji", "attrs": {"shortName": ":smile:", "id": "1f604", "text": "😄"}}
+			]}
+		]}`
+		var adfDoc jira4claude.ADF
+		require.NoError(t, json.Unmarshal([]byte(original), &adfDoc))
+
+		md, warnings := converter.ToMarkdown(adfDoc)
+		require.Empty(t, warnings)
+		assert.True(t, strings.HasPrefix(md, "https://example.atlassian.net/browse/PROJ-1\n\n"), "plain Jira link stays readable:\n%s", md)
+
+		result, warnings := converter.ToADF(md)
+		require.Empty(t, warnings)
+
+		got, err := json.Marshal(result)
+		require.NoError(t, err)
+		assert.JSONEq(t, original, string(got), "markdown:\n%s", md)
+	})
+
+	t.Run("warns about marks markdown cannot express", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "red", "marks": []any{map[string]any{"type": "textColor", "attrs": map[string]any{"color": "#ff5630"}}}},
+						map[string]any{"type": "text", "text": " and "},
+						map[string]any{"type": "text", "text": "underlined", "marks": []any{map[string]any{"type": "underline"}, map[string]any{"type": "strong"}}},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Equal(t, "red and **underlined**", result)
+		assert.Equal(t, []string{"skipped unsupported mark type 'textColor'", "skipped unsupported mark type 'underline'"}, warnings)
+	})
+
+	t.Run("keeps adf fenced block with invalid JSON as code block", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("```adf\nnot json\n```")
+
+		assert.Empty(t, warnings)
+		content := result["content"].([]any)
+		require.Len(t, content, 1)
+		assert.Equal(t, "codeBlock", content[0].(map[string]any)["type"])
+	})
+}
+
+// Property tests
+
+// genMarkdown generates a random markdown document from the constructs the
+// converter supports. The generator is seeded for reproducible failures.
+func genMarkdown(r *rand.Rand) string {
+	blocks := make([]string, 1+r.IntN(5))
+	for i := range blocks {
+		blocks[i] = genBlock(r, 0)
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func genBlock(r *rand.Rand, depth int) string {
+	switch r.IntN(6) {
+	case 0:
+		return strings.Repeat("#", 1+r.IntN(6)) + " " + genInline(r)
+	case 1:
+		return genList(r, depth, false)
+	case 2:
+		return genList(r, depth, true)
+	case 3:
+		return genCodeBlock(r)
+	case 4:
+		return "> " + strings.ReplaceAll(genParagraph(r), "\n", "\n> ")
+	default:
+		return genParagraph(r)
+	}
+}
+
+// genList generates a bullet or ordered list whose items may contain nested
+// lists, code blocks, and continuation paragraphs.
+func genList(r *rand.Rand, depth int, ordered bool) string {
+	items := make([]string, 1+r.IntN(3))
+	for i := range items {
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", i+1)
+		}
+		indent := strings.Repeat(" ", len(marker))
+
+		body := genParagraph(r)
+		if depth < 2 && r.IntN(3) == 0 {
+			body += "\n" + genList(r, depth+1, r.IntN(2) == 0)
+		}
+		if r.IntN(4) == 0 {
+			body += "\n\n" + genCodeBlock(r)
+		}
+		items[i] = marker + strings.ReplaceAll(body, "\n", "\n"+indent)
+	}
+	return strings.Join(items, "\n")
+}
+
+func genCodeBlock(r *rand.Rand) string {
+	return "```go\n" + genWord(r) + "()\n```"
+}
+
+// genParagraph generates one or more lines of inline content separated by line breaks.
+func genParagraph(r *rand.Rand) string {
+	lines := make([]string, 1+r.IntN(2))
+	for i := range lines {
+		lines[i] = genInline(r)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func genInline(r *rand.Rand) string {
+	parts := make([]string, 1+r.IntN(4))
+	for i := range parts {
+		word := genWord(r)
+		switch r.IntN(7) {
+		case 0:
+			parts[i] = "**" + word + "**"
+		case 1:
+			parts[i] = "*" + word + "*"
+		case 2:
+			parts[i] = "`" + word + "`"
+		case 3:
+			parts[i] = "[" + word + "](https://example.com/" + word + ")"
+		case 4:
+			parts[i] = "[`" + word + "`](https://example.com/" + word + ")"
+		default:
+			parts[i] = word
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func genWord(r *rand.Rand) string {
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta"}
+	return words[r.IntN(len(words))]
+}
+
+func TestConverter_ToADF_ProducesValidADF(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(1, 2))
+	converter := markdown.New()
+	for range 500 {
+		md := genMarkdown(r)
+
+		adfDoc, _ := converter.ToADF(md)
+
+		warnings, err := adf.Validate(adfDoc)
+
+		require.NoError(t, err, "markdown:\n%s", md)
+		require.Empty(t, warnings, "markdown:\n%s", md)
+	}
+}
+
+func TestConverter_RoundTripPreservesADF(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(3, 4))
+	converter := markdown.New()
+	for range 500 {
+		md := genMarkdown(r)
+
+		// Markdown -> ADF -> Markdown -> ADF must reach a fixed point
+		first, warnings := converter.ToADF(md)
+		require.Empty(t, warnings, "markdown:\n%s", md)
+		rendered, warnings := converter.ToMarkdown(first)
+		require.Empty(t, warnings, "markdown:\n%s", md)
+		second, _ := converter.ToADF(rendered)
+
+		require.Equal(t, first, second, "markdown:\n%s\nrendered:\n%s", md, rendered)
+	}
+}
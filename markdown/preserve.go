@@ -0,0 +1,66 @@
+package markdown
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// adfFenceLanguage is the fenced code block language used to embed opaque ADF nodes.
+const adfFenceLanguage = "adf"
+
+// adfInlinePrefix marks an inline code span that embeds an opaque ADF node.
+const adfInlinePrefix = "adf:"
+
+// embedBlockADF renders a block-level ADF node as a fenced ```adf block containing its JSON.
+// Returns false if the node cannot be encoded.
+func embedBlockADF(node map[string]any) (string, bool) {
+	data, err := json.MarshalIndent(node, "", "  ")
+	if err != nil {
+		return "", false
+	}
+	fence := backtickFence(string(data), 3)
+	return fence + adfFenceLanguage + "\n" + string(data) + "\n" + fence, true
+}
+
+// embedInlineADF renders an inline ADF node as a code span containing "adf:" and its JSON.
+// Returns false if the node cannot be encoded.
+func embedInlineADF(node map[string]any) (string, bool) {
+	data, err := json.Marshal(node)
+	if err != nil {
+		return "", false
+	}
+	code := adfInlinePrefix + string(data)
+	fence := backtickFence(code, 1)
+	if len(fence) > 1 {
+		return fence + " " + code + " " + fence, true
+	}
+	return fence + code + fence, true
+}
+
+// restoreADF parses embedded ADF JSON back into a node.
+// Returns false if the text is not a JSON object with a node type.
+func restoreADF(text string) (map[string]any, bool) {
+	var node map[string]any
+	if err := json.Unmarshal([]byte(text), &node); err != nil {
+		return nil, false
+	}
+	if nodeType, ok := node["type"].(string); !ok || nodeType == "" {
+		return nil, false
+	}
+	return node, true
+}
+
+// backtickFence returns a run of backticks at least minLen long and longer
+// than any backtick run in s, so s can be safely enclosed by it.
+func backtickFence(s string, minLen int) string {
+	longest, current := 0, 0
+	for _, r := range s {
+		if r == '`' {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+	return strings.Repeat("`", max(minLen, longest+1))
+}
@@ -3,7 +3,6 @@ package markdown
 import (
 	"fmt"
 	"io"
-	"slices"
 	"strings"
 
 	"github.com/fwojciec/jira4claude"
@@ -62,6 +61,15 @@ func (p *Printer) Issue(view jira4claude.IssueView) {
 	if len(view.Labels) > 0 {
 		fmt.Fprintf(p.out, "**Labels:** %s\n", strings.Join(view.Labels, ", "))
 	}
+	if len(view.Components) > 0 {
+		fmt.Fprintf(p.out, "**Components:** %s\n", strings.Join(view.Components, ", "))
+	}
+	if len(view.AffectsVersions) > 0 {
+		fmt.Fprintf(p.out, "**Affects Versions:** %s\n", strings.Join(view.AffectsVersions, ", "))
+	}
+	if len(view.FixVersions) > 0 {
+		fmt.Fprintf(p.out, "**Fix Versions:** %s\n", strings.Join(view.FixVersions, ", "))
+	}
 
 	// Description - passes through as-is (already markdown)
 	if view.Description != "" {
@@ -120,7 +128,44 @@ func (p *Printer) Transitions(key string, ts []*jira4claude.Transition) {
 	}
 
 	for _, t := range ts {
-		fmt.Fprintf(p.out, "- %s\n", t.Name)
+		line := "- " + t.Name
+		if t.To != "" && t.To != t.Name {
+			line += " → " + t.To
+		}
+		var required []string
+		for _, f := range t.Fields {
+			if !f.Required {
+				continue
+			}
+			field := f.Name + " (" + f.ID + ")"
+			if len(f.AllowedValues) > 0 {
+				field += ": " + strings.Join(f.AllowedValues, ", ")
+			}
+			required = append(required, field)
+		}
+		if len(required) > 0 {
+			line += " [requires " + strings.Join(required, "; ") + "]"
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// Drafts prints issues that would be created, numbered in creation order,
+// each with its description.
+func (p *Printer) Drafts(views []jira4claude.IssueView) {
+	if len(views) == 0 {
+		fmt.Fprintln(p.out, "[info] No issues to create")
+		return
+	}
+
+	for i, view := range views {
+		if i > 0 {
+			fmt.Fprintln(p.out)
+		}
+		fmt.Fprintf(p.out, "## %d. %s\n", i+1, view.Summary)
+		if view.Description != "" {
+			fmt.Fprintf(p.out, "\n%s\n", view.Description)
+		}
 	}
 }
 
@@ -134,6 +179,113 @@ func (p *Printer) Links(key string, links []jira4claude.RelatedIssueView) {
 	p.renderRelatedIssuesGrouped(links)
 }
 
+// LinkTypes prints link types as a markdown list with their outward and
+// inward descriptions.
+func (p *Printer) LinkTypes(types []*jira4claude.IssueLinkType) {
+	if len(types) == 0 {
+		fmt.Fprintln(p.out, "[info] No link types found")
+		return
+	}
+
+	for _, t := range types {
+		fmt.Fprintf(p.out, "- **%s**: A %s B, B %s A\n", t.Name, t.Outward, t.Inward)
+	}
+}
+
+// Users prints users as a markdown list with their account IDs.
+func (p *Printer) Users(users []*jira4claude.User) {
+	if len(users) == 0 {
+		fmt.Fprintln(p.out, "[info] No users found")
+		return
+	}
+
+	for _, u := range users {
+		line := "- **" + u.DisplayName + "**"
+		if u.Email != "" {
+			line += " <" + u.Email + ">"
+		}
+		fmt.Fprintln(p.out, line+" ("+u.AccountID+")")
+	}
+}
+
+// Versions prints project versions as a markdown list with their release state.
+func (p *Printer) Versions(versions []*jira4claude.Version) {
+	if len(versions) == 0 {
+		fmt.Fprintln(p.out, "[info] No versions found")
+		return
+	}
+
+	for _, v := range versions {
+		state := "unreleased"
+		if v.Released {
+			state = "released"
+		}
+		if v.ReleaseDate != "" {
+			state += " " + v.ReleaseDate
+		}
+		if v.Archived {
+			state += ", archived"
+		}
+		line := fmt.Sprintf("- **%s** [%s]", v.Name, state)
+		if v.Description != "" {
+			line += " " + v.Description
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// Profiles prints config profiles as a markdown list.
+// The active profile is marked with * and the default profile is noted.
+func (p *Printer) Profiles(profiles []*jira4claude.Profile) {
+	if len(profiles) == 0 {
+		fmt.Fprintln(p.out, "[info] No profiles defined")
+		return
+	}
+
+	for _, pr := range profiles {
+		marker := "-"
+		if pr.Active {
+			marker = "*"
+		}
+		line := fmt.Sprintf("%s **%s** %s (%s)", marker, pr.Name, pr.Server, pr.Project)
+		if pr.Default {
+			line += " [default]"
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// Settings prints config settings one per line, with the origin when set.
+func (p *Printer) Settings(settings []*jira4claude.ConfigSetting) {
+	if len(settings) == 0 {
+		fmt.Fprintln(p.out, "[info] No settings")
+		return
+	}
+
+	for _, s := range settings {
+		line := fmt.Sprintf("- **%s:** %s", s.Key, s.Value)
+		if s.Origin != "" {
+			line += " (" + s.Origin + ")"
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// Checks prints diagnostic checks one per line with [ok], [fail] or [skip].
+// Failed checks include their error code.
+func (p *Printer) Checks(checks []*jira4claude.Check) {
+	for _, c := range checks {
+		switch {
+		case c.Skipped:
+			fmt.Fprintf(p.out, "[skip] %s\n", c.Name)
+		case c.Code != "":
+			fmt.Fprintf(p.out, "[fail] %s: %s (%s)\n", c.Name, c.Message, c.Code)
+		default:
+			fmt.Fprintf(p.out, "[ok] %s: %s\n", c.Name, c.Message)
+		}
+	}
+}
+
 // Success prints a success message to stdout.
 func (p *Printer) Success(msg string, keys ...string) {
 	if len(keys) > 0 {
@@ -172,53 +324,47 @@ func (p *Printer) renderComment(view jira4claude.CommentView) {
 	fmt.Fprintf(p.out, "**%s** (%s):\n%s\n", author, created, view.Body)
 }
 
-// renderRelatedIssuesGrouped groups related issues by relationship type and renders them.
-// Groups are displayed in a fixed order: subtask -> blocks -> is blocked by.
+// renderRelatedIssuesGrouped groups related issues by relationship and renders
+// the groups in the order their first member appears. The views list
+// subtasks, then outward links, inward links and web links (see
+// jira4claude.ToRelatedIssuesView), so the order follows each link's type and
+// direction rather than a fixed list of known types.
 func (p *Printer) renderRelatedIssuesGrouped(related []jira4claude.RelatedIssueView) {
-	// Fixed display order for relationship types (parent is excluded, shown in metadata)
-	relationshipOrder := []string{"subtask", "blocks", "is blocked by"}
-
-	// Group by relationship type
+	var order []string
 	grouped := make(map[string][]jira4claude.RelatedIssueView)
 	for _, rel := range related {
-		grouped[rel.Relationship] = append(grouped[rel.Relationship], rel)
-	}
-
-	// Render in fixed order, then any remaining types
-	first := true
-	for _, relType := range relationshipOrder {
-		if issues, exists := grouped[relType]; exists {
-			if !first {
-				fmt.Fprintln(p.out)
-			}
-			first = false
-			fmt.Fprintf(p.out, "**%s:**\n", relType)
-			for _, rel := range issues {
-				fmt.Fprintln(p.out, formatRelatedIssueItem(rel.Key, rel.Status, rel.Type, rel.Summary))
-			}
-			delete(grouped, relType)
+		if _, ok := grouped[rel.Relationship]; !ok {
+			order = append(order, rel.Relationship)
 		}
+		grouped[rel.Relationship] = append(grouped[rel.Relationship], rel)
 	}
 
-	// Render any remaining relationship types not in the fixed order (sorted for deterministic output)
-	remainingTypes := make([]string, 0, len(grouped))
-	for relType := range grouped {
-		remainingTypes = append(remainingTypes, relType)
-	}
-	slices.Sort(remainingTypes)
-
-	for _, relType := range remainingTypes {
-		if !first {
+	for i, relType := range order {
+		if i > 0 {
 			fmt.Fprintln(p.out)
 		}
-		first = false
 		fmt.Fprintf(p.out, "**%s:**\n", relType)
 		for _, rel := range grouped[relType] {
-			fmt.Fprintln(p.out, formatRelatedIssueItem(rel.Key, rel.Status, rel.Type, rel.Summary))
+			fmt.Fprintln(p.out, formatRelatedItem(rel))
 		}
 	}
 }
 
+// formatRelatedItem formats a related issue, or a web link as a markdown link.
+// Issue links end with their ID, which link delete --id accepts.
+// Format: - [Title](URL)
+// Format: - **KEY** [Status] (Type) Summary (link ID)
+func formatRelatedItem(rel jira4claude.RelatedIssueView) string {
+	if rel.URL != "" {
+		return fmt.Sprintf("- [%s](%s)", rel.Summary, rel.URL)
+	}
+	item := formatRelatedIssueItem(rel.Key, rel.Status, rel.Type, rel.Summary)
+	if rel.LinkID != "" {
+		item += " (link " + rel.LinkID + ")"
+	}
+	return item
+}
+
 // formatRelatedIssueItem formats a related issue item with type annotation.
 // Format: - **KEY** [Status] (Type) Summary
 func formatRelatedIssueItem(key, status, issueType, summary string) string {
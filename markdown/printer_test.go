@@ -9,6 +9,7 @@ import (
 	"github.com/fwojciec/jira4claude"
 	"github.com/fwojciec/jira4claude/markdown"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPrinter_Issue(t *testing.T) {
@@ -28,6 +29,8 @@ func TestPrinter_Issue(t *testing.T) {
 			Assignee:    "Filip Wojciechowski",
 			Reporter:    "Filip Wojciechowski",
 			Labels:      []string{"backend", "cleanup"},
+			Components:  []string{"cli"},
+			FixVersions: []string{"1.2", "2.0"},
 			Description: "Code review identified stale TODO comments.",
 			RelatedIssues: []jira4claude.RelatedIssueView{
 				{Relationship: "parent", Key: "J4C-96", Type: "Epic", Status: "In Progress", Summary: "Parent epic"},
@@ -56,6 +59,9 @@ func TestPrinter_Issue(t *testing.T) {
 		assert.Contains(t, result, "**Reporter:** Filip Wojciechowski")
 		assert.Contains(t, result, "**Parent:** J4C-96")
 		assert.Contains(t, result, "**Labels:** backend, cleanup")
+		assert.Contains(t, result, "**Components:** cli")
+		assert.Contains(t, result, "**Fix Versions:** 1.2, 2.0")
+		assert.NotContains(t, result, "**Affects Versions:**")
 		// Description
 		assert.Contains(t, result, "Code review identified stale TODO comments.")
 		// Related Issues section - unified display grouped by relationship type with (Type)
@@ -149,34 +155,37 @@ func TestPrinter_Issue(t *testing.T) {
 		assert.Contains(t, result, "- **J4C-103** [Won't Do] (Sub-task) Subtask won't do")
 	})
 
-	t.Run("renders related issues in fixed order", func(t *testing.T) {
+	t.Run("orders related issue groups by link direction", func(t *testing.T) {
 		t.Parallel()
 		var out bytes.Buffer
 		p := markdown.NewPrinter(&out)
 
-		// Provide issues in scrambled order - output should be in fixed order
-		view := jira4claude.IssueView{
-			Key:     "J4C-200",
-			Summary: "Test fixed ordering",
-			Type:    "Task",
-			Status:  "To Do",
-			RelatedIssues: []jira4claude.RelatedIssueView{
-				{Relationship: "is blocked by", Key: "J4C-201", Type: "Task", Status: "Done", Summary: "Blocker done"},
-				{Relationship: "blocks", Key: "J4C-202", Type: "Task", Status: "To Do", Summary: "Blocked task"},
-				{Relationship: "subtask", Key: "J4C-203", Type: "Sub-task", Status: "To Do", Summary: "Child task"},
+		// Links in scrambled order, including types without special handling
+		duplicates := jira4claude.IssueLinkType{Name: "Duplicate", Outward: "duplicates", Inward: "is duplicated by"}
+		blocks := jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}
+		issue := &jira4claude.Issue{
+			Key: "J4C-200",
+			Links: []*jira4claude.IssueLink{
+				{ID: "1", Type: blocks, InwardIssue: &jira4claude.LinkedIssue{Key: "J4C-201"}},
+				{ID: "2", Type: duplicates, InwardIssue: &jira4claude.LinkedIssue{Key: "J4C-202"}},
+				{ID: "3", Type: duplicates, OutwardIssue: &jira4claude.LinkedIssue{Key: "J4C-203"}},
+				{ID: "4", Type: blocks, OutwardIssue: &jira4claude.LinkedIssue{Key: "J4C-204"}},
 			},
+			Subtasks: []*jira4claude.LinkedIssue{{Key: "J4C-205"}},
 		}
+		view := jira4claude.IssueView{Key: "J4C-200", RelatedIssues: jira4claude.ToRelatedIssuesView(issue)}
 
 		p.Issue(view)
 		result := out.String()
 
-		// Fixed order: subtask -> blocks -> is blocked by
-		subtaskIdx := strings.Index(result, "**subtask:**")
-		blocksIdx := strings.Index(result, "**blocks:**")
-		isBlockedByIdx := strings.Index(result, "**is blocked by:**")
-
-		assert.Less(t, subtaskIdx, blocksIdx, "subtask should appear before blocks")
-		assert.Less(t, blocksIdx, isBlockedByIdx, "blocks should appear before is blocked by")
+		// Subtasks, then outward links, then inward links
+		var idx []int
+		for _, group := range []string{"subtask", "duplicates", "blocks", "is blocked by", "is duplicated by"} {
+			i := strings.Index(result, "**"+group+":**")
+			require.GreaterOrEqual(t, i, 0, group)
+			idx = append(idx, i)
+		}
+		assert.IsIncreasing(t, idx)
 	})
 }
 
@@ -360,6 +369,11 @@ func TestPrinter_Transitions(t *testing.T) {
 			{ID: "1", Name: "In Progress"},
 			{ID: "2", Name: "Done"},
 			{ID: "3", Name: "Blocked"},
+			{ID: "4", Name: "Reopen", To: "To Do"},
+			{ID: "5", Name: "Close", To: "Closed", Fields: []*jira4claude.TransitionField{
+				{ID: "comment", Name: "Comment"},
+				{ID: "resolution", Name: "Resolution", Required: true, AllowedValues: []string{"Done", "Won't Do"}},
+			}},
 		}
 
 		p.Transitions("J4C-100", transitions)
@@ -368,6 +382,8 @@ func TestPrinter_Transitions(t *testing.T) {
 		assert.Contains(t, result, "- In Progress")
 		assert.Contains(t, result, "- Done")
 		assert.Contains(t, result, "- Blocked")
+		assert.Contains(t, result, "- Reopen → To Do\n")
+		assert.Contains(t, result, "- Close → Closed [requires Resolution (resolution): Done, Won't Do]")
 	})
 
 	t.Run("empty transitions shows info message", func(t *testing.T) {
@@ -382,6 +398,129 @@ func TestPrinter_Transitions(t *testing.T) {
 	})
 }
 
+func TestPrinter_Users(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders users with email and account ID", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Users([]*jira4claude.User{
+			{AccountID: "acc-1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+			{AccountID: "acc-2", DisplayName: "John Roe"},
+		})
+		result := out.String()
+
+		assert.Contains(t, result, "- **Jane Doe** <jane@example.com> (acc-1)")
+		assert.Contains(t, result, "- **John Roe** (acc-2)")
+	})
+
+	t.Run("empty users shows info message", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Users(nil)
+
+		assert.Contains(t, out.String(), "[info] No users found")
+	})
+}
+
+func TestPrinter_Versions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders versions with release state", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Versions([]*jira4claude.Version{
+			{Name: "1.0", Released: true, ReleaseDate: "2024-05-01", Archived: true},
+			{Name: "1.1", Description: "Spring release"},
+		})
+		result := out.String()
+
+		assert.Contains(t, result, "- **1.0** [released 2024-05-01, archived]")
+		assert.Contains(t, result, "- **1.1** [unreleased] Spring release")
+	})
+
+	t.Run("empty versions shows info message", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Versions(nil)
+
+		assert.Contains(t, out.String(), "[info] No versions found")
+	})
+}
+
+func TestPrinter_Profiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders profiles marking active and default", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Profiles([]*jira4claude.Profile{
+			{Name: "ops", Server: "https://one.atlassian.net", Project: "OPS", Default: true},
+			{Name: "web", Server: "https://two.atlassian.net", Project: "WEB", Active: true},
+		})
+		result := out.String()
+
+		assert.Contains(t, result, "- **ops** https://one.atlassian.net (OPS) [default]")
+		assert.Contains(t, result, "* **web** https://two.atlassian.net (WEB)")
+	})
+
+	t.Run("empty profiles shows info message", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Profiles(nil)
+
+		assert.Contains(t, out.String(), "[info] No profiles defined")
+	})
+}
+
+func TestPrinter_Settings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders settings with origins when present", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Settings([]*jira4claude.ConfigSetting{
+			{Key: "server", Value: "https://example.atlassian.net", Origin: "/home/me/.jira4claude.yaml"},
+			{Key: "project", Value: "TEST"},
+		})
+		result := out.String()
+
+		assert.Contains(t, result, "- **server:** https://example.atlassian.net (/home/me/.jira4claude.yaml)\n")
+		assert.Contains(t, result, "- **project:** TEST\n")
+	})
+}
+
+func TestPrinter_Checks(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p := markdown.NewPrinter(&out)
+
+	p.Checks([]*jira4claude.Check{
+		{Name: "credentials", Message: "netrc entry for example.atlassian.net"},
+		{Name: "auth", Code: jira4claude.EUnauthorized, Message: "Client must be authenticated"},
+		{Name: "project", Skipped: true},
+	})
+
+	assert.Equal(t, "[ok] credentials: netrc entry for example.atlassian.net\n"+
+		"[fail] auth: Client must be authenticated (unauthorized)\n"+
+		"[skip] project\n", out.String())
+}
+
 func TestPrinter_Links(t *testing.T) {
 	t.Parallel()
 
@@ -406,6 +545,18 @@ func TestPrinter_Links(t *testing.T) {
 		assert.Contains(t, result, "- **J4C-74** [Done] (Task) Inject Converter into CLI")
 	})
 
+	t.Run("ends issue links with their link ID", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Links("J4C-100", []jira4claude.RelatedIssueView{
+			{Relationship: "blocks", Key: "J4C-78", Type: "Task", Status: "To Do", Summary: "Rename adf package", LinkID: "10001"},
+		})
+
+		assert.Equal(t, "**blocks:**\n- **J4C-78** [To Do] (Task) Rename adf package (link 10001)\n", out.String())
+	})
+
 	t.Run("empty links shows info message", func(t *testing.T) {
 		t.Parallel()
 		var out bytes.Buffer
@@ -416,6 +567,69 @@ func TestPrinter_Links(t *testing.T) {
 
 		assert.Contains(t, result, "[info] No links for J4C-100")
 	})
+
+	t.Run("renders web links as markdown links", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Links("J4C-100", []jira4claude.RelatedIssueView{
+			{Relationship: "pull request", Summary: "PR #42", URL: "https://github.com/org/repo/pull/42"},
+		})
+
+		assert.Equal(t, "**pull request:**\n- [PR #42](https://github.com/org/repo/pull/42)\n", out.String())
+	})
+}
+
+func TestPrinter_Drafts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("numbers drafts and includes descriptions", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Drafts([]jira4claude.IssueView{
+			{Summary: "Add endpoint", Type: "Sub-task", Description: "- detail"},
+			{Summary: "Write docs", Type: "Sub-task"},
+		})
+
+		assert.Equal(t, "## 1. Add endpoint\n\n- detail\n\n## 2. Write docs\n", out.String())
+	})
+
+	t.Run("empty drafts shows info message", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.Drafts(nil)
+
+		assert.Contains(t, out.String(), "[info] No issues to create")
+	})
+}
+
+func TestPrinter_LinkTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders each type with both directions", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.LinkTypes([]*jira4claude.IssueLinkType{{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"}})
+
+		assert.Equal(t, "- **Blocks**: A blocks B, B is blocked by A\n", out.String())
+	})
+
+	t.Run("empty types shows info message", func(t *testing.T) {
+		t.Parallel()
+		var out bytes.Buffer
+		p := markdown.NewPrinter(&out)
+
+		p.LinkTypes(nil)
+
+		assert.Contains(t, out.String(), "[info] No link types found")
+	})
 }
 
 func TestPrinter_Success(t *testing.T) {
@@ -2,8 +2,11 @@ package markdown
 
 import (
 	"fmt"
+	"maps"
+	"net/url"
 	"reflect"
-	"sort"
+	"slices"
+	"strings"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
@@ -12,43 +15,64 @@ import (
 	"github.com/yuin/goldmark/text"
 )
 
-// skippedCollector tracks node types that were skipped during conversion.
-// Each unique node type generates one warning.
+// skippedCollector tracks node and mark types that were skipped during conversion,
+// and other content that was changed to fit ADF.
+// Each unique type or note generates one warning.
 type skippedCollector struct {
 	types map[string]struct{}
+	marks map[string]struct{}
+	notes map[string]struct{}
 }
 
 func newSkippedCollector() *skippedCollector {
-	return &skippedCollector{types: make(map[string]struct{})}
+	return &skippedCollector{
+		types: make(map[string]struct{}),
+		marks: make(map[string]struct{}),
+		notes: make(map[string]struct{}),
+	}
 }
 
 func (s *skippedCollector) add(nodeType string) {
 	s.types[nodeType] = struct{}{}
 }
 
-// warnings returns a slice of warning messages for each skipped node type.
-// Warnings are sorted alphabetically by node type for deterministic output.
-// Returns nil if no nodes were skipped.
+func (s *skippedCollector) addMark(markType string) {
+	s.marks[markType] = struct{}{}
+}
+
+// note records a change made to content so it fits ADF.
+func (s *skippedCollector) note(msg string) {
+	s.notes[msg] = struct{}{}
+}
+
+// warnings returns a slice of warning messages for each skipped node type,
+// followed by one for each skipped mark type and then the notes.
+// Warnings are sorted alphabetically by type for deterministic output.
+// Returns nil if nothing was skipped.
 func (s *skippedCollector) warnings() []string {
-	if len(s.types) == 0 {
-		return nil
+	var warnings []string
+	for _, t := range slices.Sorted(maps.Keys(s.types)) {
+		warnings = append(warnings, fmt.Sprintf("skipped unsupported node type '%s'", t))
 	}
-	types := make([]string, 0, len(s.types))
-	for t := range s.types {
-		types = append(types, t)
-	}
-	sort.Strings(types)
-	warnings := make([]string, len(types))
-	for i, t := range types {
-		warnings[i] = fmt.Sprintf("skipped unsupported node type '%s'", t)
+	for _, t := range slices.Sorted(maps.Keys(s.marks)) {
+		warnings = append(warnings, fmt.Sprintf("skipped unsupported mark type '%s'", t))
 	}
+	warnings = append(warnings, slices.Sorted(maps.Keys(s.notes))...)
 	return warnings
 }
 
+// adfRenderer holds the options and state of one markdown to ADF conversion.
+type adfRenderer struct {
+	jiraHost string            // Host of the configured Jira server, whose URLs become smart links
+	skipped  *skippedCollector // Content dropped from the output, reported as warnings
+}
+
 // toADF converts GitHub-flavored markdown to Atlassian Document Format (ADF).
 // The result can be used directly in Jira API requests for description and comment fields.
 // Returns warnings for any elements that were skipped during conversion.
-func toADF(markdown string) (map[string]any, []string) {
+// URLs on jiraHost or an atlassian.net site that stand alone on their line
+// become smart links.
+func toADF(markdown, jiraHost string) (map[string]any, []string) {
 	md := goldmark.New(
 		goldmark.WithExtensions(extension.GFM),
 		goldmark.WithParserOptions(
@@ -59,8 +83,8 @@ func toADF(markdown string) (map[string]any, []string) {
 	reader := text.NewReader([]byte(markdown))
 	doc := md.Parser().Parse(reader)
 
-	skipped := newSkippedCollector()
-	content := convertNode(doc, []byte(markdown), skipped)
+	r := &adfRenderer{jiraHost: jiraHost, skipped: newSkippedCollector()}
+	content := convertNode(doc, []byte(markdown), r)
 	if content == nil {
 		content = []any{}
 	}
@@ -69,15 +93,15 @@ func toADF(markdown string) (map[string]any, []string) {
 		"type":    "doc",
 		"version": 1,
 		"content": content,
-	}, skipped.warnings()
+	}, r.skipped.warnings()
 }
 
 // convertNode recursively converts goldmark AST nodes to ADF nodes.
-func convertNode(node ast.Node, source []byte, skipped *skippedCollector) []any {
+func convertNode(node ast.Node, source []byte, r *adfRenderer) []any {
 	var content []any
 
 	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
-		adfNode := nodeToADF(child, source, skipped)
+		adfNode := nodeToADF(child, source, r)
 		if adfNode != nil {
 			content = append(content, adfNode)
 		}
@@ -87,31 +111,31 @@ func convertNode(node ast.Node, source []byte, skipped *skippedCollector) []any
 }
 
 // nodeToADF converts a single goldmark AST node to an ADF node.
-func nodeToADF(node ast.Node, source []byte, skipped *skippedCollector) map[string]any {
+func nodeToADF(node ast.Node, source []byte, r *adfRenderer) map[string]any {
 	switch n := node.(type) {
 	case *ast.Paragraph:
-		return convertParagraph(n, source)
+		return convertParagraph(n, source, r)
 	case *ast.TextBlock:
-		return convertTextBlock(n, source)
+		return convertTextBlock(n, source, r)
 	case *ast.Heading:
-		return convertHeading(n, source)
+		return convertHeading(n, source, r)
 	case *ast.FencedCodeBlock:
 		return convertFencedCodeBlock(n, source)
 	case *ast.List:
-		return convertList(n, source, skipped)
+		return convertList(n, source, r)
 	case *ast.Blockquote:
-		return convertBlockquote(n, source, skipped)
+		return convertBlockquote(n, source, r)
 	default:
 		// Record the skipped node type
 		typeName := reflect.TypeOf(node).Elem().Name()
-		skipped.add(typeName)
+		r.skipped.add(typeName)
 		return nil
 	}
 }
 
 // convertParagraph converts a goldmark paragraph to an ADF paragraph.
-func convertParagraph(node *ast.Paragraph, source []byte) map[string]any {
-	content := convertInlineContent(node, source)
+func convertParagraph(node *ast.Paragraph, source []byte, r *adfRenderer) map[string]any {
+	content := convertInlineContent(node, source, r)
 	if len(content) == 0 {
 		return nil
 	}
@@ -122,8 +146,8 @@ func convertParagraph(node *ast.Paragraph, source []byte) map[string]any {
 }
 
 // convertTextBlock converts a goldmark text block (used in tight lists) to an ADF paragraph.
-func convertTextBlock(node *ast.TextBlock, source []byte) map[string]any {
-	content := convertInlineContent(node, source)
+func convertTextBlock(node *ast.TextBlock, source []byte, r *adfRenderer) map[string]any {
+	content := convertInlineContent(node, source, r)
 	if len(content) == 0 {
 		return nil
 	}
@@ -134,8 +158,8 @@ func convertTextBlock(node *ast.TextBlock, source []byte) map[string]any {
 }
 
 // convertHeading converts a goldmark heading to an ADF heading.
-func convertHeading(node *ast.Heading, source []byte) map[string]any {
-	content := convertInlineContent(node, source)
+func convertHeading(node *ast.Heading, source []byte, r *adfRenderer) map[string]any {
+	content := convertInlineContent(node, source, r)
 	if len(content) == 0 {
 		return nil
 	}
@@ -172,6 +196,11 @@ func convertFencedCodeBlock(node *ast.FencedCodeBlock, source []byte) map[string
 	}
 
 	lang := string(node.Language(source))
+	if lang == adfFenceLanguage {
+		if adfNode, ok := restoreADF(codeText); ok {
+			return adfNode
+		}
+	}
 	if lang != "" {
 		result["attrs"] = map[string]any{
 			"language": lang,
@@ -182,7 +211,7 @@ func convertFencedCodeBlock(node *ast.FencedCodeBlock, source []byte) map[string
 }
 
 // convertList converts a goldmark list to an ADF bulletList or orderedList.
-func convertList(node *ast.List, source []byte, skipped *skippedCollector) map[string]any {
+func convertList(node *ast.List, source []byte, r *adfRenderer) map[string]any {
 	listType := "bulletList"
 	if node.IsOrdered() {
 		listType = "orderedList"
@@ -191,19 +220,28 @@ func convertList(node *ast.List, source []byte, skipped *skippedCollector) map[s
 	var items []any
 	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
 		if listItem, ok := child.(*ast.ListItem); ok {
-			items = append(items, convertListItem(listItem, source, skipped))
+			items = append(items, convertListItem(listItem, source, r))
 		}
 	}
 
-	return map[string]any{
+	result := map[string]any{
 		"type":    listType,
 		"content": items,
 	}
+	if node.IsOrdered() && node.Start != 1 {
+		result["attrs"] = map[string]any{"order": node.Start}
+	}
+	return result
 }
 
 // convertListItem converts a goldmark list item to an ADF listItem.
-func convertListItem(node *ast.ListItem, source []byte, skipped *skippedCollector) map[string]any {
-	content := convertNode(node, source, skipped)
+// ADF only allows paragraphs, lists, and code blocks inside list items, and the
+// first child must be a paragraph or code block, so other blocks are adapted.
+func convertListItem(node *ast.ListItem, source []byte, r *adfRenderer) map[string]any {
+	content := flattenNested(convertNode(node, source, r), "list items", r)
+	if len(content) == 0 || !isListItemStart(content[0]) {
+		content = append([]any{map[string]any{"type": "paragraph"}}, content...)
+	}
 	return map[string]any{
 		"type":    "listItem",
 		"content": content,
@@ -211,19 +249,60 @@ func convertListItem(node *ast.ListItem, source []byte, skipped *skippedCollecto
 }
 
 // convertBlockquote converts a goldmark blockquote to an ADF blockquote.
-func convertBlockquote(node *ast.Blockquote, source []byte, skipped *skippedCollector) map[string]any {
-	content := convertNode(node, source, skipped)
+func convertBlockquote(node *ast.Blockquote, source []byte, r *adfRenderer) map[string]any {
+	content := flattenNested(convertNode(node, source, r), "blockquotes", r)
+	if len(content) == 0 {
+		content = []any{map[string]any{"type": "paragraph"}}
+	}
 	return map[string]any{
 		"type":    "blockquote",
 		"content": content,
 	}
 }
 
+// flattenNested adapts blocks that ADF does not allow inside list items and
+// blockquotes: headings become paragraphs with the same inline content, and
+// nested blockquotes are replaced by their children. Each adaptation is
+// reported as a warning naming the container.
+func flattenNested(content []any, container string, r *adfRenderer) []any {
+	result := make([]any, 0, len(content))
+	for _, item := range content {
+		node, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch node["type"] {
+		case "heading":
+			r.skipped.note("converted heading to paragraph; ADF does not allow headings in " + container)
+			result = append(result, map[string]any{
+				"type":    "paragraph",
+				"content": node["content"],
+			})
+		case "blockquote":
+			r.skipped.note("removed quote markup; ADF does not allow blockquotes in " + container)
+			children, _ := node["content"].([]any)
+			result = append(result, children...)
+		default:
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// isListItemStart reports whether an ADF node may be the first child of a listItem.
+func isListItemStart(item any) bool {
+	node, ok := item.(map[string]any)
+	if !ok {
+		return false
+	}
+	return node["type"] == "paragraph" || node["type"] == "codeBlock"
+}
+
 // convertInlineContent converts the inline content of a block node to ADF text nodes.
-func convertInlineContent(node ast.Node, source []byte) []any {
+func convertInlineContent(node ast.Node, source []byte, r *adfRenderer) []any {
 	var content []any
 	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
-		inlineNodes := convertInlineNode(child, source, nil)
+		inlineNodes := convertInlineNode(child, source, nil, r)
 		content = append(content, inlineNodes...)
 	}
 	return consolidateTextNodes(content)
@@ -349,23 +428,32 @@ func textNodeWithMarks(text string, marks []map[string]any) map[string]any {
 }
 
 // convertChildren recursively converts all children of a node with the given marks.
-func convertChildren(node ast.Node, source []byte, marks []map[string]any) []any {
+func convertChildren(node ast.Node, source []byte, marks []map[string]any, r *adfRenderer) []any {
 	var content []any
 	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
-		content = append(content, convertInlineNode(child, source, marks)...)
+		content = append(content, convertInlineNode(child, source, marks, r)...)
 	}
 	return content
 }
 
 // convertInlineNode converts inline nodes (text, emphasis, etc.) to ADF text nodes.
-func convertInlineNode(node ast.Node, source []byte, marks []map[string]any) []any {
+func convertInlineNode(node ast.Node, source []byte, marks []map[string]any, r *adfRenderer) []any {
 	switch n := node.(type) {
 	case *ast.Text:
 		text := string(n.Segment.Value(source))
-		if text == "" {
-			return nil
+		// Soft line breaks only wrap the source and read as spaces; hard breaks
+		// (two trailing spaces or a backslash) become hardBreak nodes.
+		if n.SoftLineBreak() && !n.HardLineBreak() {
+			text += " "
+		}
+		var result []any
+		if text != "" {
+			result = append(result, textNodeWithMarks(text, marks))
+		}
+		if n.HardLineBreak() {
+			result = append(result, map[string]any{"type": "hardBreak"})
 		}
-		return []any{textNodeWithMarks(text, marks)}
+		return result
 
 	case *ast.Emphasis:
 		markType := "em"
@@ -373,7 +461,7 @@ func convertInlineNode(node ast.Node, source []byte, marks []map[string]any) []a
 			markType = "strong"
 		}
 		newMarks := append(marks, map[string]any{"type": markType})
-		return convertChildren(n, source, newMarks)
+		return convertChildren(n, source, newMarks, r)
 
 	case *ast.CodeSpan:
 		var codeText string
@@ -382,9 +470,42 @@ func convertInlineNode(node ast.Node, source []byte, marks []map[string]any) []a
 				codeText += string(textNode.Segment.Value(source))
 			}
 		}
-		newMarks := append(marks, map[string]any{"type": "code"})
+		if rawADF, ok := strings.CutPrefix(codeText, adfInlinePrefix); ok {
+			if adfNode, ok := restoreADF(rawADF); ok {
+				return []any{adfNode}
+			}
+		}
+		// ADF does not allow the code mark alongside formatting marks; keep only links
+		var newMarks []map[string]any
+		for _, m := range marks {
+			if m["type"] == "link" {
+				newMarks = append(newMarks, m)
+			} else {
+				r.skipped.note(fmt.Sprintf("removed %s formatting from inline code, which Jira does not allow", m["type"]))
+			}
+		}
+		newMarks = append(newMarks, map[string]any{"type": "code"})
 		return []any{textNodeWithMarks(codeText, newMarks)}
 
+	case *ast.AutoLink:
+		href := string(n.URL(source))
+		if n.AutoLinkType == ast.AutoLinkURL && isOwnLine(n) && isAtlassianURL(href, r.jiraHost) {
+			return []any{map[string]any{
+				"type":  "inlineCard",
+				"attrs": map[string]any{"url": href},
+			}}
+		}
+		if n.AutoLinkType == ast.AutoLinkEmail {
+			href = "mailto:" + href
+		}
+		newMark := map[string]any{
+			"type": "link",
+			"attrs": map[string]any{
+				"href": href,
+			},
+		}
+		return []any{textNodeWithMarks(string(n.Label(source)), append(marks, newMark))}
+
 	case *ast.Link:
 		newMark := map[string]any{
 			"type": "link",
@@ -392,9 +513,41 @@ func convertInlineNode(node ast.Node, source []byte, marks []map[string]any) []a
 				"href": string(n.Destination),
 			},
 		}
-		return convertChildren(n, source, append(marks, newMark))
+		return convertChildren(n, source, append(marks, newMark), r)
 
 	default:
-		return convertChildren(node, source, marks)
+		return convertChildren(node, source, marks, r)
+	}
+}
+
+// isOwnLine reports whether an inline node is the only content on its line.
+// Goldmark marks line ends with soft or hard line breaks on the preceding Text
+// node, and emits an empty Text node carrying the break after a trailing link.
+func isOwnLine(node ast.Node) bool {
+	if prev := node.PreviousSibling(); prev != nil {
+		text, ok := prev.(*ast.Text)
+		if !ok || !(text.SoftLineBreak() || text.HardLineBreak()) {
+			return false
+		}
+	}
+	if next := node.NextSibling(); next != nil {
+		text, ok := next.(*ast.Text)
+		if !ok || text.Segment.Len() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isAtlassianURL reports whether href points at an atlassian.net site or the
+// host of the configured Jira server, which Jira renders as a smart link
+// (inlineCard). The path is not considered: /browse/ and /wiki/ URLs on other
+// hosts are ordinary links.
+func isAtlassianURL(href, jiraHost string) bool {
+	u, err := url.Parse(href)
+	if err != nil || u.Host == "" {
+		return false
 	}
+	host := strings.ToLower(u.Host)
+	return strings.HasSuffix(host, ".atlassian.net") || (jiraHost != "" && host == strings.ToLower(jiraHost))
 }
@@ -154,6 +154,48 @@ func TestConverter_ToADF(t *testing.T) {
 		assert.Equal(t, expected, result)
 	})
 
+	t.Run("drops emphasis around inline code with a warning but keeps links", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("***`emphasized`*** and [`linked`](https://example.com)")
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{
+							"type":  "text",
+							"text":  "emphasized",
+							"marks": []any{map[string]any{"type": "code"}},
+						},
+						map[string]any{
+							"type": "text",
+							"text": " and ",
+						},
+						map[string]any{
+							"type": "text",
+							"text": "linked",
+							"marks": []any{
+								map[string]any{"type": "link", "attrs": map[string]any{"href": "https://example.com"}},
+								map[string]any{"type": "code"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t, []string{
+			"removed em formatting from inline code, which Jira does not allow",
+			"removed strong formatting from inline code, which Jira does not allow",
+		}, warnings)
+		assert.Equal(t, expected, result)
+	})
+
 	t.Run("converts fenced code block to codeBlock node", func(t *testing.T) {
 		t.Parallel()
 
@@ -661,4 +703,223 @@ func TestConverter_ToADF(t *testing.T) {
 		// Should have 2 separate text nodes with different mark counts
 		assert.Len(t, paragraphContent, 2)
 	})
+
+	t.Run("converts Jira URL on its own line to inlineCard", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("https://example.atlassian.net/browse/PROJ-1")
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{
+							"type":  "inlineCard",
+							"attrs": map[string]any{"url": "https://example.atlassian.net/browse/PROJ-1"},
+						},
+					},
+				},
+			},
+		}
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("keeps Jira URL inside a sentence as a link", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("Fixed in https://example.atlassian.net/browse/PROJ-1 today")
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Fixed in "},
+						map[string]any{
+							"type": "text",
+							"text": "https://example.atlassian.net/browse/PROJ-1",
+							"marks": []any{
+								map[string]any{
+									"type":  "link",
+									"attrs": map[string]any{"href": "https://example.atlassian.net/browse/PROJ-1"},
+								},
+							},
+						},
+						map[string]any{"type": "text", "text": " today"},
+					},
+				},
+			},
+		}
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("keeps non-Atlassian URL on its own line as a link", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("https://github.com/org/repo/pull/1")
+
+		assert.Empty(t, warnings)
+		content := result["content"].([]any)
+		require.Len(t, content, 1)
+		paragraphContent := content[0].(map[string]any)["content"].([]any)
+		require.Len(t, paragraphContent, 1)
+		textNode := paragraphContent[0].(map[string]any)
+		assert.Equal(t, "text", textNode["type"])
+		assert.Equal(t, "https://github.com/org/repo/pull/1", textNode["text"])
+	})
+
+	t.Run("keeps browse and wiki paths on other hosts as links", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New(markdown.WithServer("https://jira.example.com"))
+
+		for _, href := range []string{"https://github.com/org/repo/wiki/Home", "https://docs.example.org/browse/guide"} {
+			result, warnings := converter.ToADF(href)
+
+			assert.Empty(t, warnings)
+			paragraphContent := result["content"].([]any)[0].(map[string]any)["content"].([]any)
+			require.Len(t, paragraphContent, 1)
+			assert.Equal(t, "text", paragraphContent[0].(map[string]any)["type"], href)
+		}
+	})
+
+	t.Run("converts URL on the configured server to inlineCard", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New(markdown.WithServer("https://jira.example.com"))
+		result, warnings := converter.ToADF("https://Jira.example.com/browse/PROJ-1")
+
+		assert.Empty(t, warnings)
+		paragraphContent := result["content"].([]any)[0].(map[string]any)["content"].([]any)
+		require.Len(t, paragraphContent, 1)
+		assert.Equal(t, map[string]any{
+			"type":  "inlineCard",
+			"attrs": map[string]any{"url": "https://Jira.example.com/browse/PROJ-1"},
+		}, paragraphContent[0])
+	})
+
+	t.Run("converts soft line breaks to spaces and hard breaks to hardBreak nodes", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("soft\nwrap  \nhard\\\nend")
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "soft wrap"},
+						map[string]any{"type": "hardBreak"},
+						map[string]any{"type": "text", "text": "hard"},
+						map[string]any{"type": "hardBreak"},
+						map[string]any{"type": "text", "text": "end"},
+					},
+				},
+			},
+		}
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("converts nested lists inside list items", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("- Parent\n  1. Child")
+
+		expected := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "bulletList",
+					"content": []any{
+						map[string]any{
+							"type": "listItem",
+							"content": []any{
+								map[string]any{
+									"type":    "paragraph",
+									"content": []any{map[string]any{"type": "text", "text": "Parent"}},
+								},
+								map[string]any{
+									"type": "orderedList",
+									"content": []any{
+										map[string]any{
+											"type": "listItem",
+											"content": []any{
+												map[string]any{
+													"type":    "paragraph",
+													"content": []any{map[string]any{"type": "text", "text": "Child"}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("sets order attr for ordered lists not starting at one", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("5. Five")
+
+		assert.Empty(t, warnings)
+		list := result["content"].([]any)[0].(map[string]any)
+		assert.Equal(t, map[string]any{"order": 5}, list["attrs"])
+	})
+
+	t.Run("flattens blocks that ADF does not allow in list items", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("- ## Heading\n\n  > Quote")
+
+		assert.Equal(t, []string{
+			"converted heading to paragraph; ADF does not allow headings in list items",
+			"removed quote markup; ADF does not allow blockquotes in list items",
+		}, warnings)
+		list := result["content"].([]any)[0].(map[string]any)
+		item := list["content"].([]any)[0].(map[string]any)
+		itemContent := item["content"].([]any)
+		require.Len(t, itemContent, 2)
+		assert.Equal(t, "paragraph", itemContent[0].(map[string]any)["type"])
+		assert.Equal(t, "paragraph", itemContent[1].(map[string]any)["type"])
+	})
+
+	t.Run("gives empty list items an empty paragraph", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		result, warnings := converter.ToADF("- a\n-")
+
+		assert.Empty(t, warnings)
+		list := result["content"].([]any)[0].(map[string]any)
+		item := list["content"].([]any)[1].(map[string]any)
+		assert.Equal(t, []any{map[string]any{"type": "paragraph"}}, item["content"])
+	})
 }
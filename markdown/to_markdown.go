@@ -2,13 +2,29 @@ package markdown
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// gfmHardBreak is a backslash line break, which unlike a plain newline
+// survives the trip back to ADF as a hardBreak.
+const gfmHardBreak = "\\\n"
+
+// gfmRenderer holds the options and state of one ADF to markdown conversion.
+type gfmRenderer struct {
+	preserve bool              // Embed content markdown cannot reproduce as opaque ADF
+	jiraHost string            // Host of the configured Jira server, whose URLs toADF turns into smart links
+	skipped  *skippedCollector // Content dropped from the output, reported as warnings
+}
+
 // toMarkdown converts an Atlassian Document Format (ADF) document to GitHub-flavored markdown.
 // This is useful for displaying Jira issue content in a readable format.
 // Returns warnings for any elements that were skipped during conversion.
-func toMarkdown(adfDoc map[string]any) (string, []string) {
+// When preserve is set, nodes and marks that markdown cannot reproduce exactly are
+// embedded as opaque ADF that toADF restores verbatim. jiraHost is passed to
+// toADF on the way back, and decides which smart links can be bare URLs.
+func toMarkdown(adfDoc map[string]any, preserve bool, jiraHost string) (string, []string) {
 	if adfDoc == nil {
 		return "", nil
 	}
@@ -18,51 +34,81 @@ func toMarkdown(adfDoc map[string]any) (string, []string) {
 		return "", nil
 	}
 
-	skipped := newSkippedCollector()
+	r := &gfmRenderer{preserve: preserve, jiraHost: jiraHost, skipped: newSkippedCollector()}
 	var parts []string
 	for _, item := range content {
 		node, ok := item.(map[string]any)
 		if !ok {
 			continue
 		}
-		part := adfNodeToGFM(node, "", skipped)
+		part := adfNodeToGFM(node, "", r)
 		if part != "" {
 			parts = append(parts, part)
 		}
 	}
 
-	return strings.Join(parts, "\n\n"), skipped.warnings()
+	return strings.Join(parts, "\n\n"), r.skipped.warnings()
 }
 
 // adfNodeToGFM converts a single ADF node to markdown.
 // The prefix is used for nested contexts like blockquotes.
-func adfNodeToGFM(node map[string]any, prefix string, skipped *skippedCollector) string {
+func adfNodeToGFM(node map[string]any, prefix string, r *gfmRenderer) string {
 	nodeType, _ := node["type"].(string)
 
 	switch nodeType {
 	case "paragraph":
-		return prefix + adfInlineToGFM(node)
+		return prefix + adfInlineToGFM(node, r)
 	case "heading":
-		return adfHeadingToGFM(node)
+		return adfHeadingToGFM(node, r)
 	case "codeBlock":
 		return adfCodeBlockToGFM(node)
 	case "bulletList":
-		return adfBulletListToGFM(node, skipped)
+		return adfBulletListToGFM(node, r)
 	case "orderedList":
-		return adfOrderedListToGFM(node, skipped)
+		return adfOrderedListToGFM(node, r)
 	case "blockquote":
-		return adfBlockquoteToGFM(node, skipped)
+		return adfBlockquoteToGFM(node, r)
+	case "blockCard":
+		// A card on its own line comes back as an inlineCard
+		if r.preserve {
+			return unsupportedBlockToGFM(node, r)
+		}
+		return adfCardToGFM(node, r)
 	case "hardBreak":
 		return "\n"
 	default:
-		// Record the skipped node type
-		skipped.add(nodeType)
-		return ""
+		return unsupportedBlockToGFM(node, r)
+	}
+}
+
+// unsupportedBlockToGFM embeds a block node that markdown cannot reproduce as a
+// fenced ```adf block in preserve mode, and otherwise records it as skipped.
+func unsupportedBlockToGFM(node map[string]any, r *gfmRenderer) string {
+	if r.preserve {
+		if embedded, ok := embedBlockADF(node); ok {
+			return embedded
+		}
 	}
+	nodeType, _ := node["type"].(string)
+	r.skipped.add(nodeType)
+	return ""
+}
+
+// unsupportedInlineToGFM embeds an inline node that markdown cannot reproduce as
+// an `adf:` code span in preserve mode, and otherwise records it as skipped.
+func unsupportedInlineToGFM(node map[string]any, r *gfmRenderer) string {
+	if r.preserve {
+		if embedded, ok := embedInlineADF(node); ok {
+			return embedded
+		}
+	}
+	nodeType, _ := node["type"].(string)
+	r.skipped.add(nodeType)
+	return ""
 }
 
 // adfHeadingToGFM converts an ADF heading to markdown.
-func adfHeadingToGFM(node map[string]any) string {
+func adfHeadingToGFM(node map[string]any, r *gfmRenderer) string {
 	level := 1
 	if attrs, ok := node["attrs"].(map[string]any); ok {
 		if l, ok := attrs["level"].(int); ok {
@@ -72,7 +118,8 @@ func adfHeadingToGFM(node map[string]any) string {
 		}
 	}
 
-	text := adfInlineToGFM(node)
+	// Markdown headings are single-line; render hard breaks as spaces
+	text := strings.ReplaceAll(adfInlineToGFM(node, r), gfmHardBreak, " ")
 	return strings.Repeat("#", level) + " " + text
 }
 
@@ -98,11 +145,12 @@ func adfCodeBlockToGFM(node map[string]any) string {
 		}
 	}
 
-	return fmt.Sprintf("```%s\n%s\n```", lang, code)
+	fence := backtickFence(code, 3)
+	return fence + lang + "\n" + code + "\n" + fence
 }
 
 // adfBulletListToGFM converts an ADF bulletList to markdown.
-func adfBulletListToGFM(node map[string]any, skipped *skippedCollector) string {
+func adfBulletListToGFM(node map[string]any, r *gfmRenderer) string {
 	content, ok := node["content"].([]any)
 	if !ok {
 		return ""
@@ -114,73 +162,104 @@ func adfBulletListToGFM(node map[string]any, skipped *skippedCollector) string {
 		if !ok || listItem["type"] != "listItem" {
 			continue
 		}
-		itemText := adfListItemToGFM(listItem, skipped)
-		items = append(items, "- "+itemText)
+		items = append(items, adfListItemToGFM(listItem, "- ", r))
 	}
 
 	return strings.Join(items, "\n")
 }
 
 // adfOrderedListToGFM converts an ADF orderedList to markdown.
-func adfOrderedListToGFM(node map[string]any, skipped *skippedCollector) string {
+// Numbering starts at the order attr when present.
+func adfOrderedListToGFM(node map[string]any, r *gfmRenderer) string {
 	content, ok := node["content"].([]any)
 	if !ok {
 		return ""
 	}
 
+	start := 1
+	if attrs, ok := node["attrs"].(map[string]any); ok {
+		if order, ok := attrs["order"].(int); ok {
+			start = order
+		} else if order, ok := attrs["order"].(float64); ok {
+			start = int(order)
+		}
+	}
+
 	items := make([]string, 0, len(content))
-	for i, item := range content {
+	for _, item := range content {
 		listItem, ok := item.(map[string]any)
 		if !ok || listItem["type"] != "listItem" {
 			continue
 		}
-		itemText := adfListItemToGFM(listItem, skipped)
-		items = append(items, fmt.Sprintf("%d. %s", i+1, itemText))
+		marker := fmt.Sprintf("%d. ", start+len(items))
+		items = append(items, adfListItemToGFM(listItem, marker, r))
 	}
 
 	return strings.Join(items, "\n")
 }
 
-// adfListItemToGFM extracts the text content from a list item.
-func adfListItemToGFM(node map[string]any, skipped *skippedCollector) string {
-	content, ok := node["content"].([]any)
-	if !ok || len(content) == 0 {
-		return ""
-	}
+// adfListItemToGFM converts a list item to markdown, starting with the given marker.
+// Every block after the first line is indented by the marker width so nested
+// lists, code blocks, and continuation lines stay inside the item.
+func adfListItemToGFM(node map[string]any, marker string, r *gfmRenderer) string {
+	content, _ := node["content"].([]any)
+	body := adfBlocksToGFM(content, r)
+	return marker + indentLines(body, strings.Repeat(" ", len(marker)))
+}
 
-	// List items typically contain paragraphs or nested lists
-	parts := make([]string, 0, len(content))
+// adfBlocksToGFM converts a sequence of nested block nodes to markdown.
+// Lists directly follow the preceding block on the next line (keeping nested
+// lists tight); other blocks are separated by a blank line so consecutive
+// paragraphs do not merge.
+func adfBlocksToGFM(content []any, r *gfmRenderer) string {
+	var result strings.Builder
 	for _, item := range content {
 		child, ok := item.(map[string]any)
 		if !ok {
 			continue
 		}
-		part := adfNodeToGFM(child, "", skipped)
-		if part != "" {
-			parts = append(parts, part)
+		part := adfNodeToGFM(child, "", r)
+		if part == "" {
+			continue
 		}
+		if result.Len() > 0 {
+			if childType := child["type"]; childType == "bulletList" || childType == "orderedList" {
+				result.WriteString("\n")
+			} else {
+				result.WriteString("\n\n")
+			}
+		}
+		result.WriteString(part)
 	}
+	return result.String()
+}
 
-	return strings.Join(parts, " ")
+// indentLines indents every line except the first. Blank lines stay empty.
+func indentLines(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
 }
 
 // adfBlockquoteToGFM converts an ADF blockquote to markdown.
-func adfBlockquoteToGFM(node map[string]any, skipped *skippedCollector) string {
+func adfBlockquoteToGFM(node map[string]any, r *gfmRenderer) string {
 	content, ok := node["content"].([]any)
 	if !ok {
 		return ""
 	}
 
-	var lines []string
-	for _, item := range content {
-		child, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		text := adfNodeToGFM(child, "", skipped)
+	body := adfBlocksToGFM(content, r)
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
 		// Prefix each line with >
-		for _, line := range strings.Split(text, "\n") {
-			lines = append(lines, "> "+line)
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
 		}
 	}
 
@@ -188,7 +267,7 @@ func adfBlockquoteToGFM(node map[string]any, skipped *skippedCollector) string {
 }
 
 // adfInlineToGFM converts inline content to markdown.
-func adfInlineToGFM(node map[string]any) string {
+func adfInlineToGFM(node map[string]any, r *gfmRenderer) string {
 	content, ok := node["content"].([]any)
 	if !ok {
 		return ""
@@ -196,37 +275,177 @@ func adfInlineToGFM(node map[string]any) string {
 
 	var result strings.Builder
 	for _, item := range content {
-		textNode, ok := item.(map[string]any)
+		inlineNode, ok := item.(map[string]any)
 		if !ok {
 			continue
 		}
-
-		if textNode["type"] == "hardBreak" {
-			result.WriteString("\n")
+		if r.preserve && inlineNode["type"] == "inlineCard" && !(len(content) == 1 && isBareCard(inlineNode, r)) {
+			result.WriteString(unsupportedInlineToGFM(inlineNode, r))
 			continue
 		}
+		result.WriteString(adfInlineNodeToGFM(inlineNode, r))
+	}
 
-		if textNode["type"] != "text" {
-			continue
+	return result.String()
+}
+
+// adfInlineNodeToGFM converts a single inline ADF node to markdown.
+func adfInlineNodeToGFM(node map[string]any, r *gfmRenderer) string {
+	nodeType, _ := node["type"].(string)
+	if r.preserve && textOnlyInline[nodeType] {
+		return unsupportedInlineToGFM(node, r)
+	}
+
+	switch nodeType {
+	case "text":
+		return adfTextToGFM(node, r)
+	case "hardBreak":
+		return gfmHardBreak
+	case "inlineCard":
+		return adfCardToGFM(node, r)
+	case "status":
+		return adfStatusToGFM(node)
+	case "date":
+		return adfDateToGFM(node, r)
+	case "emoji":
+		return adfEmojiToGFM(node)
+	default:
+		return unsupportedInlineToGFM(node, r)
+	}
+}
+
+// textOnlyInline lists the inline nodes rendered as text that toADF reads back
+// as plain text.
+var textOnlyInline = map[string]bool{"status": true, "date": true, "emoji": true}
+
+// isBareCard reports whether an inlineCard renders as a URL that toADF turns
+// back into the same card when it stands alone on its line.
+func isBareCard(node map[string]any, r *gfmRenderer) bool {
+	attrs, _ := node["attrs"].(map[string]any)
+	href, _ := attrs["url"].(string)
+	return len(node) == 2 && len(attrs) == 1 && isAtlassianURL(href, r.jiraHost)
+}
+
+// adfCardToGFM converts an ADF inlineCard or blockCard (smart link) to a bare URL.
+// GFM autolinks bare URLs, and toADF turns Jira/Confluence URLs on their own
+// line back into inlineCard nodes.
+func adfCardToGFM(node map[string]any, r *gfmRenderer) string {
+	attrs, _ := node["attrs"].(map[string]any)
+	if href, ok := attrs["url"].(string); ok && href != "" {
+		return href
+	}
+	// Cards may carry JSON-LD data instead of a URL
+	if data, ok := attrs["data"].(map[string]any); ok {
+		if href, ok := data["url"].(string); ok && href != "" {
+			return href
 		}
+	}
+	if node["type"] == "blockCard" {
+		return unsupportedBlockToGFM(node, r)
+	}
+	return unsupportedInlineToGFM(node, r)
+}
 
-		text, _ := textNode["text"].(string)
-		marks, hasMarks := textNode["marks"].([]any)
+// adfStatusToGFM converts an ADF status lozenge to [STATUS].
+func adfStatusToGFM(node map[string]any) string {
+	attrs, _ := node["attrs"].(map[string]any)
+	text, _ := attrs["text"].(string)
+	return "[" + strings.ToUpper(text) + "]"
+}
 
-		if !hasMarks || len(marks) == 0 {
-			result.WriteString(text)
-			continue
+// adfDateToGFM converts an ADF date node to an ISO 8601 date (YYYY-MM-DD).
+// The timestamp attribute holds milliseconds since the Unix epoch, as a string.
+func adfDateToGFM(node map[string]any, r *gfmRenderer) string {
+	attrs, _ := node["attrs"].(map[string]any)
+	var millis int64
+	switch ts := attrs["timestamp"].(type) {
+	case string:
+		parsed, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return unsupportedInlineToGFM(node, r)
 		}
+		millis = parsed
+	case float64:
+		millis = int64(ts)
+	case int:
+		millis = int64(ts)
+	default:
+		return unsupportedInlineToGFM(node, r)
+	}
+	return time.UnixMilli(millis).UTC().Format(time.DateOnly)
+}
 
-		// Apply marks
-		result.WriteString(applyMarks(text, marks))
+// adfEmojiToGFM converts an ADF emoji to its :shortcode: form.
+// Falls back to the emoji's text representation when no short name is present.
+func adfEmojiToGFM(node map[string]any) string {
+	attrs, _ := node["attrs"].(map[string]any)
+	if shortName, ok := attrs["shortName"].(string); ok && shortName != "" {
+		return ":" + strings.Trim(shortName, ":") + ":"
 	}
+	text, _ := attrs["text"].(string)
+	return text
+}
 
-	return result.String()
+// adfTextToGFM converts an ADF text node to markdown. Marks markdown cannot
+// express are dropped with a warning, or in preserve mode the node is embedded
+// as ADF along with any other node whose marks would not survive the round trip.
+func adfTextToGFM(node map[string]any, r *gfmRenderer) string {
+	text, _ := node["text"].(string)
+	marks, _ := node["marks"].([]any)
+	if len(marks) == 0 {
+		return text
+	}
+	unsupported := unsupportedMarks(marks)
+	if r.preserve && (len(unsupported) > 0 || !marksRoundTrip(marks)) {
+		if embedded, ok := embedInlineADF(node); ok {
+			return embedded
+		}
+	}
+	for _, markType := range unsupported {
+		r.skipped.addMark(markType)
+	}
+	return applyMarks(text, marks, r.preserve)
+}
+
+// unsupportedMarks returns the types of the marks markdown cannot express.
+func unsupportedMarks(marks []any) []string {
+	var types []string
+	for _, mark := range marks {
+		markMap, _ := mark.(map[string]any)
+		switch markType, _ := markMap["type"].(string); markType {
+		case "strong", "em", "code", "link":
+		default:
+			types = append(types, markType)
+		}
+	}
+	return types
+}
+
+// marksRoundTrip reports whether toADF reads back supported marks as they are.
+// Emphasis is dropped inside code spans and links keep only their href.
+func marksRoundTrip(marks []any) bool {
+	var hasCode, hasEmphasis bool
+	for _, mark := range marks {
+		markMap, _ := mark.(map[string]any)
+		attrs, _ := markMap["attrs"].(map[string]any)
+		switch markMap["type"] {
+		case "code":
+			hasCode = true
+		case "strong", "em":
+			hasEmphasis = true
+		case "link":
+			if _, ok := attrs["href"].(string); !ok || len(attrs) != 1 || len(markMap) != 2 {
+				return false
+			}
+		}
+	}
+	return !(hasCode && hasEmphasis)
 }
 
 // applyMarks wraps text with the appropriate markdown syntax for its marks.
-func applyMarks(text string, marks []any) string {
+// Links whose text is the URL stay bare unless explicit is set, since toADF
+// turns bare Jira URLs on their own line into smart links.
+func applyMarks(text string, marks []any, explicit bool) string {
 	var hasStrong, hasEm, hasCode bool
 	var linkHref string
 
@@ -271,6 +490,10 @@ func applyMarks(text string, marks []any) string {
 		}
 	}
 	if linkHref != "" {
+		// Bare URLs stay bare; GFM autolinks them back into links
+		if result == linkHref && !explicit {
+			return result
+		}
 		result = "[" + result + "](" + linkHref + ")"
 	}
 
@@ -703,4 +703,224 @@ func TestConverter_ToMarkdown(t *testing.T) {
 		assert.Empty(t, warnings)
 		assert.Equal(t, "# Default Heading", result)
 	})
+
+	t.Run("renders smart links, status, date and emoji inline", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Fixed in "},
+						map[string]any{
+							"type":  "inlineCard",
+							"attrs": map[string]any{"url": "https://example.atlassian.net/browse/PROJ-1"},
+						},
+						map[string]any{"type": "text", "text": " by "},
+						map[string]any{
+							"type":  "date",
+							"attrs": map[string]any{"timestamp": "1767225600000"},
+						},
+						map[string]any{"type": "text", "text": " "},
+						map[string]any{
+							"type":  "status",
+							"attrs": map[string]any{"text": "In Review", "color": "blue"},
+						},
+						map[string]any{"type": "text", "text": " "},
+						map[string]any{
+							"type":  "emoji",
+							"attrs": map[string]any{"shortName": ":tada:", "text": "🎉"},
+						},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "Fixed in https://example.atlassian.net/browse/PROJ-1 by 2026-01-01 [IN REVIEW] :tada:", result)
+	})
+
+	t.Run("renders blockCard as a bare URL", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type":  "blockCard",
+					"attrs": map[string]any{"url": "https://example.atlassian.net/wiki/spaces/ENG/pages/1"},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "https://example.atlassian.net/wiki/spaces/ENG/pages/1", result)
+	})
+
+	t.Run("warns about unsupported inline nodes", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "Hi "},
+						map[string]any{"type": "mention", "attrs": map[string]any{"id": "abc"}},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Equal(t, "Hi ", result)
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "mention")
+	})
+
+	t.Run("indents nested lists and code blocks inside list items", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type":  "orderedList",
+					"attrs": map[string]any{"order": float64(9)},
+					"content": []any{
+						map[string]any{
+							"type": "listItem",
+							"content": []any{
+								map[string]any{
+									"type":    "paragraph",
+									"content": []any{map[string]any{"type": "text", "text": "Parent"}},
+								},
+								map[string]any{
+									"type": "bulletList",
+									"content": []any{
+										map[string]any{
+											"type": "listItem",
+											"content": []any{
+												map[string]any{
+													"type":    "paragraph",
+													"content": []any{map[string]any{"type": "text", "text": "Child"}},
+												},
+											},
+										},
+									},
+								},
+								map[string]any{
+									"type":    "codeBlock",
+									"content": []any{map[string]any{"type": "text", "text": "make"}},
+								},
+							},
+						},
+						map[string]any{
+							"type": "listItem",
+							"content": []any{
+								map[string]any{
+									"type":    "paragraph",
+									"content": []any{map[string]any{"type": "text", "text": "Next"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "9. Parent\n   - Child\n\n   ```\n   make\n   ```\n10. Next", result)
+	})
+
+	t.Run("renders hard breaks as backslash line breaks", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type": "paragraph",
+					"content": []any{
+						map[string]any{"type": "text", "text": "One"},
+						map[string]any{"type": "hardBreak"},
+						map[string]any{"type": "text", "text": "Two"},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "One\\\nTwo", result)
+	})
+
+	t.Run("renders hard breaks inside headings as spaces", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type":  "heading",
+					"attrs": map[string]any{"level": 2},
+					"content": []any{
+						map[string]any{"type": "text", "text": "One"},
+						map[string]any{"type": "hardBreak"},
+						map[string]any{"type": "text", "text": "Two", "marks": []any{map[string]any{"type": "em"}}},
+					},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "## One *Two*", result)
+	})
+
+	t.Run("uses a longer fence when code contains backticks", func(t *testing.T) {
+		t.Parallel()
+
+		converter := markdown.New()
+		adfDoc := map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{
+					"type":    "codeBlock",
+					"attrs":   map[string]any{"language": "md"},
+					"content": []any{map[string]any{"type": "text", "text": "```go\nx\n```"}},
+				},
+			},
+		}
+
+		result, warnings := converter.ToMarkdown(adfDoc)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, "````md\n```go\nx\n```\n````", result)
+	})
 }
@@ -9,9 +9,44 @@ var _ jira4claude.ConfigService = (*ConfigService)(nil)
 // Each method delegates to its corresponding function field (e.g., Init calls InitFn).
 // Calling a method without setting its function field will panic.
 type ConfigService struct {
-	InitFn func(dir, server, project string) (*jira4claude.InitResult, error)
+	InitFn     func(dir, server, project string) (*jira4claude.InitResult, error)
+	ProfilesFn func(paths []string) ([]*jira4claude.Profile, error)
+	ShowFn     func(paths []string, profile string) ([]*jira4claude.ConfigSetting, error)
+	LoadFn     func(paths []string, profile string) (*jira4claude.Config, error)
+	GetFn      func(paths []string, profile, key string) (*jira4claude.ConfigSetting, error)
+	SetFn      func(path, key, value string) error
+	UnsetFn    func(path, key string) error
+	ValidateFn func(paths []string, profile string) error
 }
 
 func (s *ConfigService) Init(dir, server, project string) (*jira4claude.InitResult, error) {
 	return s.InitFn(dir, server, project)
 }
+
+func (s *ConfigService) Profiles(paths []string) ([]*jira4claude.Profile, error) {
+	return s.ProfilesFn(paths)
+}
+
+func (s *ConfigService) Show(paths []string, profile string) ([]*jira4claude.ConfigSetting, error) {
+	return s.ShowFn(paths, profile)
+}
+
+func (s *ConfigService) Load(paths []string, profile string) (*jira4claude.Config, error) {
+	return s.LoadFn(paths, profile)
+}
+
+func (s *ConfigService) Get(paths []string, profile, key string) (*jira4claude.ConfigSetting, error) {
+	return s.GetFn(paths, profile, key)
+}
+
+func (s *ConfigService) Set(path, key, value string) error {
+	return s.SetFn(path, key, value)
+}
+
+func (s *ConfigService) Unset(path, key string) error {
+	return s.UnsetFn(path, key)
+}
+
+func (s *ConfigService) Validate(paths []string, profile string) error {
+	return s.ValidateFn(paths, profile)
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.DiagnosticService = (*DiagnosticService)(nil)
+
+// DiagnosticService is a mock implementation of jira4claude.DiagnosticService.
+// Calling a method without setting its function field will panic.
+type DiagnosticService struct {
+	DiagnoseFn func(ctx context.Context, cfg *jira4claude.Config) []*jira4claude.Check
+}
+
+func (s *DiagnosticService) Diagnose(ctx context.Context, cfg *jira4claude.Config) []*jira4claude.Check {
+	return s.DiagnoseFn(ctx, cfg)
+}
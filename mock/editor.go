@@ -0,0 +1,16 @@
+package mock
+
+import "github.com/fwojciec/jira4claude"
+
+// Compile-time interface verification.
+var _ jira4claude.Editor = (*Editor)(nil)
+
+// Editor is a mock implementation of jira4claude.Editor.
+// Calling a method without setting its function field will panic.
+type Editor struct {
+	EditFn func(text string) (string, error)
+}
+
+func (e *Editor) Edit(text string) (string, error) {
+	return e.EditFn(text)
+}
@@ -29,17 +29,25 @@ var _ jira4claude.IssueService = (*IssueService)(nil)
 // Each method delegates to its corresponding function field (e.g., Get calls GetFn).
 // Calling a method without setting its function field will panic.
 type IssueService struct {
-	CreateFn      func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error)
-	GetFn         func(ctx context.Context, key string) (*jira4claude.Issue, error)
-	ListFn        func(ctx context.Context, filter jira4claude.IssueFilter) ([]*jira4claude.Issue, error)
-	UpdateFn      func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error)
-	DeleteFn      func(ctx context.Context, key string) error
-	AddCommentFn  func(ctx context.Context, key string, body jira4claude.ADF) (*jira4claude.Comment, error)
-	TransitionsFn func(ctx context.Context, key string) ([]*jira4claude.Transition, error)
-	TransitionFn  func(ctx context.Context, key, transitionID string) error
-	AssignFn      func(ctx context.Context, key, accountID string) error
-	LinkFn        func(ctx context.Context, inwardKey, linkType, outwardKey string) error
-	UnlinkFn      func(ctx context.Context, key1, key2 string) error
+	CreateFn        func(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error)
+	GetFn           func(ctx context.Context, key string) (*jira4claude.Issue, error)
+	ListFn          func(ctx context.Context, filter jira4claude.IssueFilter) ([]*jira4claude.Issue, error)
+	UpdateFn        func(ctx context.Context, key string, update jira4claude.IssueUpdate) (*jira4claude.Issue, error)
+	DeleteFn        func(ctx context.Context, key string) error
+	AddCommentFn    func(ctx context.Context, key string, body jira4claude.ADF) (*jira4claude.Comment, error)
+	TransitionsFn   func(ctx context.Context, key string) ([]*jira4claude.Transition, error)
+	TransitionFn    func(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error
+	AssignFn        func(ctx context.Context, key, accountID string) error
+	WatchersFn      func(ctx context.Context, key string) ([]*jira4claude.User, error)
+	AddWatcherFn    func(ctx context.Context, key, accountID string) error
+	RemoveWatcherFn func(ctx context.Context, key, accountID string) error
+	VoteFn          func(ctx context.Context, key string) error
+	UnvoteFn        func(ctx context.Context, key string) error
+	LinkFn          func(ctx context.Context, inwardKey, linkType, outwardKey string) error
+	DeleteLinkFn    func(ctx context.Context, linkID string) error
+	LinkTypesFn     func(ctx context.Context) ([]*jira4claude.IssueLinkType, error)
+	RemoteLinksFn   func(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error)
+	AddRemoteLinkFn func(ctx context.Context, key string, link *jira4claude.RemoteLink) (*jira4claude.RemoteLink, error)
 }
 
 func (s *IssueService) Create(ctx context.Context, issue *jira4claude.Issue) (*jira4claude.Issue, error) {
@@ -70,18 +78,50 @@ func (s *IssueService) Transitions(ctx context.Context, key string) ([]*jira4cla
 	return s.TransitionsFn(ctx, key)
 }
 
-func (s *IssueService) Transition(ctx context.Context, key, transitionID string) error {
-	return s.TransitionFn(ctx, key, transitionID)
+func (s *IssueService) Transition(ctx context.Context, key, transitionID string, input jira4claude.TransitionInput) error {
+	return s.TransitionFn(ctx, key, transitionID, input)
 }
 
 func (s *IssueService) Assign(ctx context.Context, key, accountID string) error {
 	return s.AssignFn(ctx, key, accountID)
 }
 
+func (s *IssueService) Watchers(ctx context.Context, key string) ([]*jira4claude.User, error) {
+	return s.WatchersFn(ctx, key)
+}
+
+func (s *IssueService) AddWatcher(ctx context.Context, key, accountID string) error {
+	return s.AddWatcherFn(ctx, key, accountID)
+}
+
+func (s *IssueService) RemoveWatcher(ctx context.Context, key, accountID string) error {
+	return s.RemoveWatcherFn(ctx, key, accountID)
+}
+
+func (s *IssueService) Vote(ctx context.Context, key string) error {
+	return s.VoteFn(ctx, key)
+}
+
+func (s *IssueService) Unvote(ctx context.Context, key string) error {
+	return s.UnvoteFn(ctx, key)
+}
+
 func (s *IssueService) Link(ctx context.Context, inwardKey, linkType, outwardKey string) error {
 	return s.LinkFn(ctx, inwardKey, linkType, outwardKey)
 }
 
-func (s *IssueService) Unlink(ctx context.Context, key1, key2 string) error {
-	return s.UnlinkFn(ctx, key1, key2)
+func (s *IssueService) DeleteLink(ctx context.Context, linkID string) error {
+	return s.DeleteLinkFn(ctx, linkID)
+}
+
+func (s *IssueService) LinkTypes(ctx context.Context) ([]*jira4claude.IssueLinkType, error) {
+	return s.LinkTypesFn(ctx)
+}
+
+func (s *IssueService) RemoteLinks(ctx context.Context, key string) ([]*jira4claude.RemoteLink, error) {
+	return s.RemoteLinksFn(ctx, key)
+}
+
+func (s *IssueService) AddRemoteLink(ctx context.Context, key string, link *jira4claude.RemoteLink) (*jira4claude.RemoteLink, error) {
+	return s.AddRemoteLinkFn(ctx, key, link)
 }
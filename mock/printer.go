@@ -14,7 +14,14 @@ type Printer struct {
 	IssuesFn      func(views []jira4claude.IssueView)
 	CommentFn     func(view jira4claude.CommentView)
 	TransitionsFn func(key string, ts []*jira4claude.Transition)
+	DraftsFn      func(views []jira4claude.IssueView)
 	LinksFn       func(key string, links []jira4claude.RelatedIssueView)
+	LinkTypesFn   func(types []*jira4claude.IssueLinkType)
+	VersionsFn    func(versions []*jira4claude.Version)
+	UsersFn       func(users []*jira4claude.User)
+	ProfilesFn    func(profiles []*jira4claude.Profile)
+	SettingsFn    func(settings []*jira4claude.ConfigSetting)
+	ChecksFn      func(checks []*jira4claude.Check)
 	SuccessFn     func(msg string, keys ...string)
 	WarningFn     func(msg string)
 	ErrorFn       func(err error)
@@ -27,11 +34,18 @@ type Printer struct {
 		Key         string
 		Transitions []*jira4claude.Transition
 	}
-	LinksCalls []struct {
+	DraftsCalls [][]jira4claude.IssueView
+	LinksCalls  []struct {
 		Key   string
 		Links []jira4claude.RelatedIssueView
 	}
-	SuccessCalls []struct {
+	LinkTypesCalls [][]*jira4claude.IssueLinkType
+	VersionsCalls  [][]*jira4claude.Version
+	UsersCalls     [][]*jira4claude.User
+	ProfilesCalls  [][]*jira4claude.Profile
+	SettingsCalls  [][]*jira4claude.ConfigSetting
+	ChecksCalls    [][]*jira4claude.Check
+	SuccessCalls   []struct {
 		Msg  string
 		Keys []string
 	}
@@ -70,6 +84,13 @@ func (p *Printer) Transitions(key string, ts []*jira4claude.Transition) {
 	}
 }
 
+func (p *Printer) Drafts(views []jira4claude.IssueView) {
+	p.DraftsCalls = append(p.DraftsCalls, views)
+	if p.DraftsFn != nil {
+		p.DraftsFn(views)
+	}
+}
+
 func (p *Printer) Links(key string, links []jira4claude.RelatedIssueView) {
 	p.LinksCalls = append(p.LinksCalls, struct {
 		Key   string
@@ -80,6 +101,48 @@ func (p *Printer) Links(key string, links []jira4claude.RelatedIssueView) {
 	}
 }
 
+func (p *Printer) LinkTypes(types []*jira4claude.IssueLinkType) {
+	p.LinkTypesCalls = append(p.LinkTypesCalls, types)
+	if p.LinkTypesFn != nil {
+		p.LinkTypesFn(types)
+	}
+}
+
+func (p *Printer) Users(users []*jira4claude.User) {
+	p.UsersCalls = append(p.UsersCalls, users)
+	if p.UsersFn != nil {
+		p.UsersFn(users)
+	}
+}
+
+func (p *Printer) Versions(versions []*jira4claude.Version) {
+	p.VersionsCalls = append(p.VersionsCalls, versions)
+	if p.VersionsFn != nil {
+		p.VersionsFn(versions)
+	}
+}
+
+func (p *Printer) Profiles(profiles []*jira4claude.Profile) {
+	p.ProfilesCalls = append(p.ProfilesCalls, profiles)
+	if p.ProfilesFn != nil {
+		p.ProfilesFn(profiles)
+	}
+}
+
+func (p *Printer) Settings(settings []*jira4claude.ConfigSetting) {
+	p.SettingsCalls = append(p.SettingsCalls, settings)
+	if p.SettingsFn != nil {
+		p.SettingsFn(settings)
+	}
+}
+
+func (p *Printer) Checks(checks []*jira4claude.Check) {
+	p.ChecksCalls = append(p.ChecksCalls, checks)
+	if p.ChecksFn != nil {
+		p.ChecksFn(checks)
+	}
+}
+
 func (p *Printer) Success(msg string, keys ...string) {
 	p.SuccessCalls = append(p.SuccessCalls, struct {
 		Msg  string
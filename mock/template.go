@@ -0,0 +1,38 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var (
+	_ jira4claude.TemplateService = (*TemplateService)(nil)
+	_ jira4claude.GitService      = (*GitService)(nil)
+)
+
+// TemplateService is a mock implementation of jira4claude.TemplateService.
+// Calling a method without setting its function field will panic.
+type TemplateService struct {
+	RenderFn     func(name string, data *jira4claude.TemplateData) (string, error)
+	RenderTextFn func(name, text string, data *jira4claude.TemplateData) (string, error)
+}
+
+func (s *TemplateService) Render(name string, data *jira4claude.TemplateData) (string, error) {
+	return s.RenderFn(name, data)
+}
+
+func (s *TemplateService) RenderText(name, text string, data *jira4claude.TemplateData) (string, error) {
+	return s.RenderTextFn(name, text, data)
+}
+
+// GitService is a mock implementation of jira4claude.GitService.
+// Calling a method without setting its function field will panic.
+type GitService struct {
+	HeadFn func(ctx context.Context) (*jira4claude.GitHead, error)
+}
+
+func (s *GitService) Head(ctx context.Context) (*jira4claude.GitHead, error) {
+	return s.HeadFn(ctx)
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.UserService = (*UserService)(nil)
+
+// UserService is a mock implementation of jira4claude.UserService.
+// Calling a method without setting its function field will panic.
+type UserService struct {
+	MeFn         func(ctx context.Context) (*jira4claude.User, error)
+	SearchFn     func(ctx context.Context, query string) ([]*jira4claude.User, error)
+	AssignableFn func(ctx context.Context, key, query string) ([]*jira4claude.User, error)
+}
+
+func (s *UserService) Me(ctx context.Context) (*jira4claude.User, error) {
+	return s.MeFn(ctx)
+}
+
+func (s *UserService) Search(ctx context.Context, query string) ([]*jira4claude.User, error) {
+	return s.SearchFn(ctx, query)
+}
+
+func (s *UserService) Assignable(ctx context.Context, key, query string) ([]*jira4claude.User, error) {
+	return s.AssignableFn(ctx, key, query)
+}
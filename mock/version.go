@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.VersionService = (*VersionService)(nil)
+
+// VersionService is a mock implementation of jira4claude.VersionService.
+// Calling a method without setting its function field will panic.
+type VersionService struct {
+	ListFn    func(ctx context.Context, project string) ([]*jira4claude.Version, error)
+	CreateFn  func(ctx context.Context, version *jira4claude.Version) (*jira4claude.Version, error)
+	ReleaseFn func(ctx context.Context, project, name, date string) (*jira4claude.Version, error)
+}
+
+func (s *VersionService) List(ctx context.Context, project string) ([]*jira4claude.Version, error) {
+	return s.ListFn(ctx, project)
+}
+
+func (s *VersionService) Create(ctx context.Context, version *jira4claude.Version) (*jira4claude.Version, error) {
+	return s.CreateFn(ctx, version)
+}
+
+func (s *VersionService) Release(ctx context.Context, project, name, date string) (*jira4claude.Version, error) {
+	return s.ReleaseFn(ctx, project, name, date)
+}
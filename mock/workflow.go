@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// Compile-time interface verification.
+var _ jira4claude.WorkflowService = (*WorkflowService)(nil)
+
+// WorkflowService is a mock implementation of jira4claude.WorkflowService.
+// Calling a method without setting its function field will panic.
+type WorkflowService struct {
+	WorkflowFn func(ctx context.Context, key string) (*jira4claude.Workflow, error)
+}
+
+func (s *WorkflowService) Workflow(ctx context.Context, key string) (*jira4claude.Workflow, error) {
+	return s.WorkflowFn(ctx, key)
+}
@@ -6,11 +6,30 @@ type IssuePrinter interface {
 	Issues(views []IssueView)
 	Comment(view CommentView)
 	Transitions(key string, ts []*Transition)
+	Drafts(views []IssueView)
 }
 
 // LinkPrinter handles link command output.
 type LinkPrinter interface {
 	Links(key string, links []RelatedIssueView)
+	LinkTypes(types []*IssueLinkType)
+}
+
+// UserPrinter handles user command output.
+type UserPrinter interface {
+	Users(users []*User)
+}
+
+// VersionPrinter handles version command output.
+type VersionPrinter interface {
+	Versions(versions []*Version)
+}
+
+// ConfigPrinter handles config command output.
+type ConfigPrinter interface {
+	Profiles(profiles []*Profile)
+	Settings(settings []*ConfigSetting)
+	Checks(checks []*Check)
 }
 
 // MessagePrinter handles success/error/warning output.
@@ -24,5 +43,8 @@ type MessagePrinter interface {
 type Printer interface {
 	IssuePrinter
 	LinkPrinter
+	VersionPrinter
+	UserPrinter
+	ConfigPrinter
 	MessagePrinter
 }
@@ -0,0 +1,40 @@
+package jira4claude
+
+import "context"
+
+// TemplateData holds the values available to description template
+// placeholders, e.g. {{.Summary}} or {{.Branch}}. Environment variables are
+// available as {{env "NAME"}}.
+type TemplateData struct {
+	Summary string
+	Type    string
+	Project string
+	Parent  string // Parent issue key; empty for top-level issues
+	Branch  string // Current git branch; empty when unknown or detached
+	Commit  string // Current git commit SHA; empty when unknown
+}
+
+// TemplateService renders named issue description templates.
+type TemplateService interface {
+	// Render fills the placeholders of the named template with data and
+	// returns the resulting markdown. Returns ENotFound listing the available
+	// templates if no template has that name.
+	Render(name string, data *TemplateData) (string, error)
+
+	// RenderText fills the placeholders of an inline template, such as a
+	// per-type template from config, with data. The name identifies the
+	// template in errors.
+	RenderText(name, text string, data *TemplateData) (string, error)
+}
+
+// GitHead describes the commit checked out in the working directory.
+type GitHead struct {
+	Branch string // Empty when HEAD is detached
+	Commit string
+}
+
+// GitService reads the state of the local git checkout.
+type GitService interface {
+	// Head returns the current branch and commit.
+	Head(ctx context.Context) (*GitHead, error)
+}
@@ -0,0 +1,166 @@
+// Package template renders issue description templates stored as markdown
+// files or given inline, using text/template for placeholders.
+package template
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/git"
+)
+
+// templateExt is the file extension of template files.
+const templateExt = ".md"
+
+// repoTemplateDir is the template directory inside a repository.
+const repoTemplateDir = ".jira4claude/templates"
+
+// Compile-time interface verification.
+var _ jira4claude.TemplateService = (*Service)(nil)
+
+// Service implements jira4claude.TemplateService using *.md files.
+// A template named "bug" is read from bug.md in the last directory that has
+// one, so later directories override earlier ones. Templates read environment
+// variables with {{env "NAME"}}.
+type Service struct {
+	dirs   []string
+	getenv func(string) string
+}
+
+// NewService creates a new Service reading templates from dirs, ordered from
+// lowest to highest precedence as returned by DiscoverDirs, and environment
+// variables from the process environment.
+func NewService(dirs ...string) *Service {
+	return &Service{dirs: dirs, getenv: os.Getenv}
+}
+
+// NewServiceWithEnv creates a new Service that reads environment variables
+// through getenv. This is useful for testing.
+func NewServiceWithEnv(getenv func(string) string, dirs ...string) *Service {
+	return &Service{dirs: dirs, getenv: getenv}
+}
+
+// DiscoverDirs returns the template directories for workDir, from lowest to
+// highest precedence:
+//
+//  1. configDir/jira4claude/templates (personal templates)
+//  2. .jira4claude/templates in each directory from the repository root down to workDir
+//
+// The repository root is the nearest ancestor with a .git directory. Outside
+// a repository only workDir is searched. An empty configDir is skipped.
+// Directories that do not exist are left out.
+func DiscoverDirs(workDir, configDir string) []string {
+	var candidates []string
+	if configDir != "" {
+		candidates = append(candidates, filepath.Join(configDir, "jira4claude", "templates"))
+	}
+	for _, dir := range git.SearchDirs(workDir) {
+		candidates = append(candidates, filepath.Join(dir, repoTemplateDir))
+	}
+
+	var dirs []string
+	for _, dir := range candidates {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// Render fills the placeholders of the named template with data.
+// Unknown placeholders are reported as EValidation errors.
+func (s *Service) Render(name string, data *jira4claude.TemplateData) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "invalid template name " + strconv.Quote(name),
+		}
+	}
+
+	path, err := s.find(name)
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EInternal,
+			Message: "failed to read template " + path,
+			Inner:   err,
+		}
+	}
+	return s.RenderText(path, string(content), data)
+}
+
+// RenderText fills the placeholders of the inline template text with data.
+// The name, such as a file path or config key, identifies the template in
+// errors. Unknown placeholders are reported as EValidation errors.
+func (s *Service) RenderText(name, text string, data *jira4claude.TemplateData) (string, error) {
+	tmpl, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"env": s.getenv}).
+		Parse(text)
+	if err != nil {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "invalid template " + name + ": " + err.Error(),
+			Inner:   err,
+		}
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "failed to render template " + name + ": " + err.Error(),
+			Inner:   err,
+		}
+	}
+	return b.String(), nil
+}
+
+// find returns the path of the highest-precedence file for the named template.
+func (s *Service) find(name string) (string, error) {
+	for _, dir := range slices.Backward(s.dirs) {
+		path := filepath.Join(dir, name+templateExt)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", &jira4claude.Error{
+				Code:    jira4claude.EInternal,
+				Message: "failed to read template " + path,
+				Inner:   err,
+			}
+		}
+	}
+
+	names := s.names()
+	msg := "template " + strconv.Quote(name) + " not found"
+	if len(names) > 0 {
+		msg += "; available: " + strings.Join(names, ", ")
+	} else {
+		msg += "; add templates to " + repoTemplateDir + "/" + name + templateExt
+	}
+	return "", &jira4claude.Error{Code: jira4claude.ENotFound, Message: msg}
+}
+
+// names returns the sorted, de-duplicated names of the available templates.
+func (s *Service) names() []string {
+	var names []string
+	for _, dir := range s.dirs {
+		matches, _ := filepath.Glob(filepath.Join(dir, "*"+templateExt))
+		for _, match := range matches {
+			name := strings.TrimSuffix(filepath.Base(match), templateExt)
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,170 @@
+package template_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/template"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTemplate writes a template file named name.md in dir.
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0o644))
+}
+
+func TestService_Render(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fills placeholders", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeTemplate(t, dir, "bug", "## {{.Summary}}\n\nFound on `{{.Branch}}` at {{.Commit}} ({{.Type}} in {{.Project}})\n")
+		svc := template.NewService(dir)
+
+		got, err := svc.Render("bug", &jira4claude.TemplateData{
+			Summary: "Login fails",
+			Type:    "Bug",
+			Project: "PROJ",
+			Branch:  "main",
+			Commit:  "abc123",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "## Login fails\n\nFound on `main` at abc123 (Bug in PROJ)\n", got)
+	})
+
+	t.Run("later directories override earlier ones", func(t *testing.T) {
+		t.Parallel()
+
+		personal := t.TempDir()
+		repo := t.TempDir()
+		writeTemplate(t, personal, "bug", "personal")
+		writeTemplate(t, repo, "bug", "repo")
+		svc := template.NewService(personal, repo)
+
+		got, err := svc.Render("bug", &jira4claude.TemplateData{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "repo", got)
+	})
+
+	t.Run("returns not found listing available templates", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeTemplate(t, dir, "spike", "")
+		writeTemplate(t, dir, "chore", "")
+		svc := template.NewService(dir)
+
+		_, err := svc.Render("bug", &jira4claude.TemplateData{})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "available: chore, spike")
+	})
+
+	t.Run("returns validation error for unknown placeholder", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeTemplate(t, dir, "bug", "{{.Reporter}}")
+		svc := template.NewService(dir)
+
+		_, err := svc.Render("bug", &jira4claude.TemplateData{})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("reads environment variables", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeTemplate(t, dir, "bug", `Reported by {{env "USER"}}{{env "UNSET"}}`)
+		env := map[string]string{"USER": "jane"}
+		svc := template.NewServiceWithEnv(func(key string) string { return env[key] }, dir)
+
+		got, err := svc.Render("bug", &jira4claude.TemplateData{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reported by jane", got)
+	})
+
+	t.Run("rejects names with path separators", func(t *testing.T) {
+		t.Parallel()
+
+		svc := template.NewService(t.TempDir())
+
+		_, err := svc.Render("../bug", &jira4claude.TemplateData{})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestService_RenderText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fills placeholders of an inline template", func(t *testing.T) {
+		t.Parallel()
+
+		svc := template.NewService()
+
+		got, err := svc.RenderText("create.templates.bug", "## {{.Summary}} ({{.Type}})", &jira4claude.TemplateData{Summary: "Login fails", Type: "Bug"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "## Login fails (Bug)", got)
+	})
+
+	t.Run("names the template in errors", func(t *testing.T) {
+		t.Parallel()
+
+		svc := template.NewService()
+
+		_, err := svc.RenderText("create.templates.bug", "{{.Reporter}}", &jira4claude.TemplateData{})
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "create.templates.bug")
+	})
+}
+
+func TestDiscoverDirs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns config and repository template dirs in precedence order", func(t *testing.T) {
+		t.Parallel()
+
+		configDir := t.TempDir()
+		repo := t.TempDir()
+		workDir := filepath.Join(repo, "sub")
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(configDir, "jira4claude", "templates"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, ".jira4claude", "templates"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(workDir, ".jira4claude", "templates"), 0o755))
+
+		dirs := template.DiscoverDirs(workDir, configDir)
+
+		assert.Equal(t, []string{
+			filepath.Join(configDir, "jira4claude", "templates"),
+			filepath.Join(repo, ".jira4claude", "templates"),
+			filepath.Join(workDir, ".jira4claude", "templates"),
+		}, dirs)
+	})
+
+	t.Run("skips directories that do not exist", func(t *testing.T) {
+		t.Parallel()
+
+		dirs := template.DiscoverDirs(t.TempDir(), "")
+
+		assert.Empty(t, dirs)
+	})
+}
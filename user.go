@@ -0,0 +1,19 @@
+package jira4claude
+
+import "context"
+
+// UserMe is the user query that refers to the authenticated user.
+const UserMe = "me"
+
+// UserService defines operations for looking up Jira users.
+type UserService interface {
+	// Me returns the authenticated user.
+	Me(ctx context.Context) (*User, error)
+
+	// Search returns active users whose display name or email matches query.
+	Search(ctx context.Context, query string) ([]*User, error)
+
+	// Assignable returns active users matching query who can be assigned the
+	// issue with the given key.
+	Assignable(ctx context.Context, key, query string) ([]*User, error)
+}
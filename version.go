@@ -0,0 +1,28 @@
+package jira4claude
+
+import "context"
+
+// Version represents a project version used for release tracking.
+type Version struct {
+	ID          string
+	Project     string // Project key
+	Name        string
+	Description string
+	Released    bool
+	Archived    bool
+	ReleaseDate string // YYYY-MM-DD; empty if not scheduled
+}
+
+// VersionService defines operations for managing project versions.
+type VersionService interface {
+	// List returns the versions of a project in the project's order.
+	List(ctx context.Context, project string) ([]*Version, error)
+
+	// Create creates a new version and returns it with ID populated.
+	Create(ctx context.Context, version *Version) (*Version, error)
+
+	// Release marks the named version of a project as released on date
+	// (YYYY-MM-DD). Returns ENotFound listing the project's versions if no
+	// version has that name.
+	Release(ctx context.Context, project, name, date string) (*Version, error)
+}
@@ -1,27 +1,32 @@
 package jira4claude
 
 import (
+	"cmp"
 	"encoding/json"
 	"time"
 )
 
 // IssueView is a display-ready representation of an issue with ADF converted to markdown.
 type IssueView struct {
-	Key           string             `json:"key"`
-	Project       string             `json:"project,omitempty"`
-	Summary       string             `json:"summary"`
-	Description   string             `json:"description,omitempty"`
-	Status        string             `json:"status"`
-	Type          string             `json:"type"`
-	Priority      string             `json:"priority,omitempty"`
-	Assignee      string             `json:"assignee,omitempty"`
-	Reporter      string             `json:"reporter,omitempty"`
-	Labels        []string           `json:"labels,omitempty"`
-	RelatedIssues []RelatedIssueView `json:"relatedIssues"`
-	Comments      []CommentView      `json:"comments,omitempty"`
-	Created       string             `json:"created"`
-	Updated       string             `json:"updated"`
-	URL           string             `json:"url,omitempty"`
+	Key             string             `json:"key"`
+	Project         string             `json:"project,omitempty"`
+	Summary         string             `json:"summary"`
+	Description     string             `json:"description,omitempty"`
+	DescriptionADF  ADF                `json:"descriptionAdf,omitempty"` // Untouched ADF; set only when raw output is requested
+	Status          string             `json:"status"`
+	Type            string             `json:"type"`
+	Priority        string             `json:"priority,omitempty"`
+	Assignee        string             `json:"assignee,omitempty"`
+	Reporter        string             `json:"reporter,omitempty"`
+	Labels          []string           `json:"labels,omitempty"`
+	Components      []string           `json:"components,omitempty"`
+	AffectsVersions []string           `json:"affectsVersions,omitempty"`
+	FixVersions     []string           `json:"fixVersions,omitempty"`
+	RelatedIssues   []RelatedIssueView `json:"relatedIssues"`
+	Comments        []CommentView      `json:"comments,omitempty"`
+	Created         string             `json:"created"`
+	Updated         string             `json:"updated"`
+	URL             string             `json:"url,omitempty"`
 }
 
 // MarshalJSON ensures RelatedIssues is always an array, never null.
@@ -38,17 +43,20 @@ type CommentView struct {
 	ID      string `json:"id"`
 	Author  string `json:"author"`
 	Body    string `json:"body"`
+	BodyADF ADF    `json:"bodyAdf,omitempty"` // Untouched ADF; set only when raw output is requested
 	Created string `json:"created"`
 }
 
 // RelatedIssueView is a unified display-ready representation of a related issue.
 // It consolidates parents, subtasks, and links into a single format.
 type RelatedIssueView struct {
-	Relationship string `json:"relationship"` // "parent", "subtask", or link type (e.g., "blocks", "is blocked by")
-	Key          string `json:"key"`
-	Type         string `json:"type"`   // "Epic", "Task", "Sub-task", etc.
-	Status       string `json:"status"` // "To Do", "In Progress", "Done", etc.
-	Summary      string `json:"summary"`
+	Relationship string `json:"relationship"`     // "parent", "subtask", link type (e.g., "blocks", "is blocked by") or web link relationship
+	Key          string `json:"key,omitempty"`    // Empty for web links
+	Type         string `json:"type"`             // "Epic", "Task", "Sub-task", etc.
+	Status       string `json:"status"`           // "To Do", "In Progress", "Done", etc.
+	Summary      string `json:"summary"`          // Issue summary, or the title of a web link
+	URL          string `json:"url,omitempty"`    // Set only for web links
+	LinkID       string `json:"linkId,omitempty"` // Issue link ID, for deleting the link; empty for parents, subtasks and web links
 }
 
 // ToIssueView converts a domain Issue to a display-ready IssueView.
@@ -85,21 +93,24 @@ func ToIssueView(issue *Issue, conv Converter, warn func(string), serverURL stri
 	}
 
 	return IssueView{
-		Key:           issue.Key,
-		Project:       issue.Project,
-		Summary:       issue.Summary,
-		Description:   description,
-		Status:        issue.Status,
-		Type:          issue.Type,
-		Priority:      issue.Priority,
-		Assignee:      displayName(issue.Assignee),
-		Reporter:      displayName(issue.Reporter),
-		Labels:        issue.Labels,
-		RelatedIssues: relatedIssues,
-		Comments:      comments,
-		Created:       issue.Created.Format(time.RFC3339),
-		Updated:       issue.Updated.Format(time.RFC3339),
-		URL:           url,
+		Key:             issue.Key,
+		Project:         issue.Project,
+		Summary:         issue.Summary,
+		Description:     description,
+		Status:          issue.Status,
+		Type:            issue.Type,
+		Priority:        issue.Priority,
+		Assignee:        displayName(issue.Assignee),
+		Reporter:        displayName(issue.Reporter),
+		Labels:          issue.Labels,
+		Components:      issue.Components,
+		AffectsVersions: issue.AffectsVersions,
+		FixVersions:     issue.FixVersions,
+		RelatedIssues:   relatedIssues,
+		Comments:        comments,
+		Created:         issue.Created.Format(time.RFC3339),
+		Updated:         issue.Updated.Format(time.RFC3339),
+		URL:             url,
 	}
 }
 
@@ -126,6 +137,20 @@ func ToCommentView(comment *Comment, conv Converter, warn func(string)) CommentV
 	}
 }
 
+// ToRemoteLinksView converts web links to RelatedIssueViews. Links without a
+// relationship are reported as "web link".
+func ToRemoteLinksView(links []*RemoteLink) []RelatedIssueView {
+	views := make([]RelatedIssueView, 0, len(links))
+	for _, link := range links {
+		views = append(views, RelatedIssueView{
+			Relationship: cmp.Or(link.Relationship, "web link"),
+			Summary:      cmp.Or(link.Title, link.URL),
+			URL:          link.URL,
+		})
+	}
+	return views
+}
+
 // ToLinksView converts a slice of domain IssueLinks to RelatedIssueViews.
 // The relationship field uses the link type's outward/inward description.
 func ToLinksView(links []*IssueLink) []RelatedIssueView {
@@ -141,6 +166,7 @@ func ToLinksView(links []*IssueLink) []RelatedIssueView {
 				Type:         link.OutwardIssue.Type,
 				Status:       link.OutwardIssue.Status,
 				Summary:      link.OutwardIssue.Summary,
+				LinkID:       link.ID,
 			})
 		}
 		if link.InwardIssue != nil {
@@ -150,6 +176,7 @@ func ToLinksView(links []*IssueLink) []RelatedIssueView {
 				Type:         link.InwardIssue.Type,
 				Status:       link.InwardIssue.Status,
 				Summary:      link.InwardIssue.Summary,
+				LinkID:       link.ID,
 			})
 		}
 	}
@@ -168,14 +195,14 @@ func displayName(user *User) string {
 }
 
 // ToRelatedIssuesView converts all related issues (parent, subtasks, links) into a unified slice.
-// Results are ordered: parent → subtasks → outward links → inward links.
+// Results are ordered: parent → subtasks → outward links → inward links → web links.
 func ToRelatedIssuesView(issue *Issue) []RelatedIssueView {
-	// Pre-allocate capacity: parent(1) + subtasks + links*2 (outward + inward)
+	// Pre-allocate capacity: parent(1) + subtasks + links*2 (outward + inward) + web links
 	parentCount := 0
 	if issue.Parent != nil {
 		parentCount = 1
 	}
-	cap := parentCount + len(issue.Subtasks) + len(issue.Links)*2
+	cap := parentCount + len(issue.Subtasks) + len(issue.Links)*2 + len(issue.RemoteLinks)
 	related := make([]RelatedIssueView, 0, cap)
 
 	// 1. Parent (at most one)
@@ -212,6 +239,7 @@ func ToRelatedIssuesView(issue *Issue) []RelatedIssueView {
 				Type:         link.OutwardIssue.Type,
 				Status:       link.OutwardIssue.Status,
 				Summary:      link.OutwardIssue.Summary,
+				LinkID:       link.ID,
 			})
 		}
 		if link.InwardIssue != nil {
@@ -221,6 +249,7 @@ func ToRelatedIssuesView(issue *Issue) []RelatedIssueView {
 				Type:         link.InwardIssue.Type,
 				Status:       link.InwardIssue.Status,
 				Summary:      link.InwardIssue.Summary,
+				LinkID:       link.ID,
 			})
 		}
 	}
@@ -229,5 +258,8 @@ func ToRelatedIssuesView(issue *Issue) []RelatedIssueView {
 	related = append(related, outward...)
 	related = append(related, inward...)
 
+	// 4. Web links
+	related = append(related, ToRemoteLinksView(issue.RemoteLinks)...)
+
 	return related
 }
@@ -284,15 +284,18 @@ func TestToIssueView(t *testing.T) {
 		}
 
 		issue := &jira4claude.Issue{
-			Key:      "TEST-1",
-			Project:  "TEST",
-			Summary:  "Test issue",
-			Status:   "To Do",
-			Type:     "Task",
-			Priority: "High",
-			Assignee: &jira4claude.User{DisplayName: "John Doe"},
-			Reporter: &jira4claude.User{DisplayName: "Jane Smith"},
-			Labels:   []string{"bug", "urgent"},
+			Key:             "TEST-1",
+			Project:         "TEST",
+			Summary:         "Test issue",
+			Status:          "To Do",
+			Type:            "Task",
+			Priority:        "High",
+			Assignee:        &jira4claude.User{DisplayName: "John Doe"},
+			Reporter:        &jira4claude.User{DisplayName: "Jane Smith"},
+			Labels:          []string{"bug", "urgent"},
+			Components:      []string{"API"},
+			AffectsVersions: []string{"1.0"},
+			FixVersions:     []string{"1.1"},
 			Parent: &jira4claude.LinkedIssue{
 				Key:     "TEST-100",
 				Summary: "Parent issue",
@@ -310,6 +313,9 @@ func TestToIssueView(t *testing.T) {
 		assert.Equal(t, "John Doe", view.Assignee)
 		assert.Equal(t, "Jane Smith", view.Reporter)
 		assert.Equal(t, []string{"bug", "urgent"}, view.Labels)
+		assert.Equal(t, []string{"API"}, view.Components)
+		assert.Equal(t, []string{"1.0"}, view.AffectsVersions)
+		assert.Equal(t, []string{"1.1"}, view.FixVersions)
 		// Parent is now in RelatedIssues
 		assert.Len(t, view.RelatedIssues, 1)
 		assert.Equal(t, "parent", view.RelatedIssues[0].Relationship)
@@ -791,4 +797,37 @@ func TestToRelatedIssuesView(t *testing.T) {
 		assert.Equal(t, "is blocked by", related[3].Relationship)
 		assert.Equal(t, "TEST-BLOCKER", related[3].Key)
 	})
+
+	t.Run("appends web links after issue links", func(t *testing.T) {
+		t.Parallel()
+
+		issue := &jira4claude.Issue{
+			Key: "TEST-1",
+			Links: []*jira4claude.IssueLink{
+				{
+					Type:         jira4claude.IssueLinkType{Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+					OutwardIssue: &jira4claude.LinkedIssue{Key: "TEST-2", Summary: "Blocked", Status: "To Do", Type: "Task"},
+				},
+			},
+			RemoteLinks: []*jira4claude.RemoteLink{
+				{ID: "10000", URL: "https://github.com/org/repo/pull/42", Title: "PR #42", Relationship: "pull request"},
+				{ID: "10001", URL: "https://ci.example.com/runs/7"},
+			},
+		}
+
+		related := jira4claude.ToRelatedIssuesView(issue)
+
+		assert.Len(t, related, 3)
+		assert.Equal(t, "TEST-2", related[0].Key)
+		assert.Equal(t, jira4claude.RelatedIssueView{
+			Relationship: "pull request",
+			Summary:      "PR #42",
+			URL:          "https://github.com/org/repo/pull/42",
+		}, related[1])
+		assert.Equal(t, jira4claude.RelatedIssueView{
+			Relationship: "web link",
+			Summary:      "https://ci.example.com/runs/7",
+			URL:          "https://ci.example.com/runs/7",
+		}, related[2])
+	})
 }
@@ -0,0 +1,88 @@
+package jira4claude
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Workflow is the status graph an issue moves through.
+type Workflow struct {
+	Name        string
+	Transitions []*WorkflowTransition
+}
+
+// WorkflowTransition is an edge of a workflow graph.
+type WorkflowTransition struct {
+	ID   string
+	Name string
+	From []string // Source status names; empty for a global transition available from every status
+	To   string   // Target status name
+}
+
+// WorkflowService reads issue workflows.
+type WorkflowService interface {
+	// Workflow returns the workflow that applies to the issue with the given key.
+	Workflow(ctx context.Context, key string) (*Workflow, error)
+}
+
+// Path returns the shortest sequence of transitions leading from status to
+// target, matching status names case-insensitively. It returns an empty path
+// when the issue is already in the target status, and an ENotFound error when
+// target is not reachable.
+func (w *Workflow) Path(from, target string) ([]*WorkflowTransition, error) {
+	if strings.EqualFold(from, target) {
+		return nil, nil
+	}
+
+	// Breadth-first search over statuses, remembering the edge into each.
+	via := map[string]*WorkflowTransition{}
+	prev := map[string]string{}
+	visited := map[string]bool{strings.ToLower(from): true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+		for _, t := range w.Transitions {
+			next := strings.ToLower(t.To)
+			if visited[next] || !t.availableFrom(status) {
+				continue
+			}
+			visited[next] = true
+			via[next] = t
+			prev[next] = status
+			if strings.EqualFold(t.To, target) {
+				return pathTo(next, from, via, prev), nil
+			}
+			queue = append(queue, t.To)
+		}
+	}
+
+	return nil, &Error{
+		Code:    ENotFound,
+		Message: "no path from " + strconv.Quote(from) + " to " + strconv.Quote(target) + " in workflow " + strconv.Quote(w.Name),
+	}
+}
+
+// availableFrom reports whether the transition can be taken from status.
+func (t *WorkflowTransition) availableFrom(status string) bool {
+	if len(t.From) == 0 {
+		return true
+	}
+	for _, from := range t.From {
+		if strings.EqualFold(from, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathTo walks the search tree back from status to the start.
+func pathTo(status, from string, via map[string]*WorkflowTransition, prev map[string]string) []*WorkflowTransition {
+	var path []*WorkflowTransition
+	for !strings.EqualFold(status, from) {
+		path = append([]*WorkflowTransition{via[status]}, path...)
+		status = strings.ToLower(prev[status])
+	}
+	return path
+}
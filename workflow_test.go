@@ -0,0 +1,82 @@
+package jira4claude_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reviewWorkflow is To Do -> In Progress -> In Review -> Done, with a
+// shortcut from In Progress to Done and a global transition back to To Do.
+func reviewWorkflow() *jira4claude.Workflow {
+	return &jira4claude.Workflow{
+		Name: "Review workflow",
+		Transitions: []*jira4claude.WorkflowTransition{
+			{ID: "11", Name: "Start", From: []string{"To Do"}, To: "In Progress"},
+			{ID: "21", Name: "Review", From: []string{"In Progress"}, To: "In Review"},
+			{ID: "31", Name: "Approve", From: []string{"In Review"}, To: "Done"},
+			{ID: "41", Name: "Ship", From: []string{"In Progress"}, To: "Done"},
+			{ID: "51", Name: "Reset", To: "To Do"},
+		},
+	}
+}
+
+func stepIDs(path []*jira4claude.WorkflowTransition) []string {
+	ids := make([]string, len(path))
+	for i, t := range path {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestWorkflow_Path(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds the shortest path", func(t *testing.T) {
+		t.Parallel()
+
+		path, err := reviewWorkflow().Path("To Do", "Done")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"11", "41"}, stepIDs(path))
+	})
+
+	t.Run("matches statuses case-insensitively", func(t *testing.T) {
+		t.Parallel()
+
+		path, err := reviewWorkflow().Path("to do", "in review")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"11", "21"}, stepIDs(path))
+	})
+
+	t.Run("uses global transitions from any status", func(t *testing.T) {
+		t.Parallel()
+
+		path, err := reviewWorkflow().Path("Done", "In Progress")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"51", "11"}, stepIDs(path))
+	})
+
+	t.Run("returns empty path when already in target status", func(t *testing.T) {
+		t.Parallel()
+
+		path, err := reviewWorkflow().Path("Done", "done")
+
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	t.Run("returns not found for unreachable status", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reviewWorkflow().Path("To Do", "Blocked")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `"Review workflow"`)
+	})
+}
@@ -4,13 +4,19 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/git"
 	"gopkg.in/yaml.v3"
 )
 
-const configFileName = ".jira4claude.yaml"
+const (
+	configFileName      = ".jira4claude.yaml"
+	localConfigFileName = ".jira4claude.local.yaml"
+)
 
 // Error constructors to reduce boilerplate.
 func validationErr(msg string) error {
@@ -28,12 +34,288 @@ func notFoundErr(msg string, inner error) error {
 // configFile represents the YAML file structure.
 // Field names are lowercase to match YAML keys.
 type configFile struct {
-	Server  string `yaml:"server"`
-	Project string `yaml:"project"`
+	profileFile    `yaml:",inline"`
+	DefaultProfile string                 `yaml:"default_profile,omitempty"`
+	Profiles       map[string]profileFile `yaml:"profiles,omitempty"`
+}
+
+// profileFile holds the values that can be set both at the top level and in
+// a named profile. Empty profile fields inherit the top-level values.
+type profileFile struct {
+	Server  string     `yaml:"server,omitempty"`
+	Project string     `yaml:"project,omitempty"`
+	Netrc   string     `yaml:"netrc,omitempty"`
+	Output  string     `yaml:"output,omitempty"`
+	Create  createFile `yaml:"create,omitempty"`
+}
+
+// createFile holds the defaults applied by issue create.
+type createFile struct {
+	Type       string            `yaml:"type,omitempty"`
+	Labels     []string          `yaml:"labels,omitempty"`
+	Priority   string            `yaml:"priority,omitempty"`
+	Components []string          `yaml:"components,omitempty"`
+	Assignee   string            `yaml:"assignee,omitempty"`
+	Watchers   []string          `yaml:"watchers,omitempty"`
+	Templates  map[string]string `yaml:"templates,omitempty"`
+}
+
+// Setting keys, in display order.
+const (
+	keyProfile          = "profile"
+	keyServer           = "server"
+	keyProject          = "project"
+	keyNetrc            = "netrc"
+	keyOutput           = "output"
+	keyCreateType       = "create.type"
+	keyCreateLabels     = "create.labels"
+	keyCreatePriority   = "create.priority"
+	keyCreateComponents = "create.components"
+	keyCreateAssignee   = "create.assignee"
+	keyCreateWatchers   = "create.watchers"
+)
+
+// keyCreateTemplates is the key holding description templates by issue type.
+// Templates are multi-line, so they are merged separately from the value keys.
+const keyCreateTemplates = "create.templates"
+
+// valueKeys returns the keys that can be set both at the top level and in a profile.
+func valueKeys() []string {
+	return []string{
+		keyServer, keyProject, keyNetrc, keyOutput,
+		keyCreateType, keyCreateLabels, keyCreatePriority, keyCreateComponents, keyCreateAssignee,
+		keyCreateWatchers,
+	}
+}
+
+// isListKey reports whether key holds a list. List values are shown and set
+// as comma-separated strings.
+func isListKey(key string) bool {
+	return key == keyCreateLabels || key == keyCreateComponents || key == keyCreateWatchers
+}
+
+// splitList splits a comma-separated list value, dropping empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// value returns the value for key, with lists joined by commas.
+func (pf profileFile) value(key string) string {
+	switch key {
+	case keyServer:
+		return pf.Server
+	case keyProject:
+		return pf.Project
+	case keyNetrc:
+		return pf.Netrc
+	case keyOutput:
+		return pf.Output
+	case keyCreateType:
+		return pf.Create.Type
+	case keyCreateLabels:
+		return strings.Join(pf.Create.Labels, ", ")
+	case keyCreatePriority:
+		return pf.Create.Priority
+	case keyCreateComponents:
+		return strings.Join(pf.Create.Components, ", ")
+	case keyCreateAssignee:
+		return pf.Create.Assignee
+	case keyCreateWatchers:
+		return strings.Join(pf.Create.Watchers, ", ")
+	default:
+		return ""
+	}
+}
+
+// layer is a parsed config file.
+type layer struct {
+	path string
+	file *configFile
+}
+
+// readLayers reads and parses each config file in order.
+func readLayers(paths []string) ([]layer, error) {
+	layers := make([]layer, 0, len(paths))
+	for _, path := range paths {
+		cf, err := readConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer{path: path, file: cf})
+	}
+	return layers, nil
 }
 
 // LoadConfig loads configuration from a YAML file at the given path.
-func LoadConfig(path string) (*jira4claude.Config, error) {
+// The profile argument selects a named profile; when empty, the file's
+// default_profile is used, and when that is empty too, the top-level values.
+func LoadConfig(path, profile string) (*jira4claude.Config, error) {
+	return LoadLayers([]string{path}, profile)
+}
+
+// LoadLayers loads configuration merged from the YAML files at paths, ordered
+// from lowest to highest precedence as returned by DiscoverConfig.
+// A value set in a later file overrides the same value in an earlier one.
+// Environment overrides are not applied; see Service.
+func LoadLayers(paths []string, profile string) (*jira4claude.Config, error) {
+	return loadLayers(paths, profile, nil)
+}
+
+// loadLayers is LoadLayers with environment overrides read through getenv.
+func loadLayers(paths []string, profile string, getenv func(string) string) (*jira4claude.Config, error) {
+	layers, err := readLayers(paths)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := resolveLayers(layers, profile, getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &jira4claude.Config{}
+	for _, setting := range settings {
+		switch setting.Key {
+		case keyProfile:
+			cfg.Profile = setting.Value
+		case keyServer:
+			cfg.Server = setting.Value
+		case keyProject:
+			cfg.Project = setting.Value
+		case keyNetrc:
+			cfg.Netrc = expandHome(setting.Value)
+		case keyOutput:
+			cfg.Output = setting.Value
+		case keyCreateType:
+			cfg.Create.Type = setting.Value
+		case keyCreateLabels:
+			cfg.Create.Labels = splitList(setting.Value)
+		case keyCreatePriority:
+			cfg.Create.Priority = setting.Value
+		case keyCreateComponents:
+			cfg.Create.Components = splitList(setting.Value)
+		case keyCreateAssignee:
+			cfg.Create.Assignee = setting.Value
+		case keyCreateWatchers:
+			cfg.Create.Watchers = splitList(setting.Value)
+		}
+	}
+	cfg.Create.Templates = mergeTemplates(layers, cfg.Profile)
+
+	if cfg.Server == "" {
+		return nil, validationErr("config missing required field: server (set it in a config file or " + envVar(keyServer) + ")")
+	}
+	if cfg.Project == "" {
+		return nil, validationErr("config missing required field: project (set it in a config file or " + envVar(keyProject) + ")")
+	}
+	if cfg.Output != "" && cfg.Output != jira4claude.OutputMarkdown && cfg.Output != jira4claude.OutputJSON {
+		return nil, validationErr("invalid output " + strconv.Quote(cfg.Output) + "; must be markdown or json")
+	}
+
+	return cfg, nil
+}
+
+// mergeTemplates merges description templates across layers, then from the
+// selected profile. A template for the same issue type in a later layer wins.
+func mergeTemplates(layers []layer, profile string) map[string]string {
+	var templates map[string]string
+	add := func(src map[string]string) {
+		for issueType, tmpl := range src {
+			if templates == nil {
+				templates = make(map[string]string)
+			}
+			templates[issueType] = tmpl
+		}
+	}
+	for _, l := range layers {
+		add(l.file.Create.Templates)
+	}
+	if profile != "" {
+		for _, l := range layers {
+			add(l.file.Profiles[profile].Create.Templates)
+		}
+	}
+	return templates
+}
+
+// Resolve merges the YAML files at paths (lowest precedence first) and returns
+// each effective setting with the file it came from. Values taken from the
+// selected profile have an origin of the form "path (profile name)".
+// Environment overrides are not applied; see Service.
+func Resolve(paths []string, profile string) ([]*jira4claude.ConfigSetting, error) {
+	return resolve(paths, profile, nil)
+}
+
+// resolve is Resolve with environment overrides read through getenv.
+func resolve(paths []string, profile string, getenv func(string) string) ([]*jira4claude.ConfigSetting, error) {
+	layers, err := readLayers(paths)
+	if err != nil {
+		return nil, err
+	}
+	return resolveLayers(layers, profile, getenv)
+}
+
+// resolveLayers merges parsed layers, then applies the selected profile, then
+// the environment variables read through getenv; see Resolve.
+func resolveLayers(layers []layer, profile string, getenv func(string) string) ([]*jira4claude.ConfigSetting, error) {
+	byKey := make(map[string]*jira4claude.ConfigSetting)
+	for _, l := range layers {
+		for _, key := range valueKeys() {
+			if v := l.file.value(key); v != "" {
+				byKey[key] = &jira4claude.ConfigSetting{Key: key, Value: v, Origin: l.path}
+			}
+		}
+	}
+
+	profileOrigin := "--profile"
+	if profile == "" {
+		for _, l := range layers {
+			if l.file.DefaultProfile != "" {
+				profile = l.file.DefaultProfile
+				profileOrigin = l.path
+			}
+		}
+	}
+	if profile != "" {
+		found := false
+		for _, l := range layers {
+			pf, ok := l.file.Profiles[profile]
+			if !ok {
+				continue
+			}
+			found = true
+			for _, key := range valueKeys() {
+				if v := pf.value(key); v != "" {
+					byKey[key] = &jira4claude.ConfigSetting{Key: key, Value: v, Origin: l.path + " (profile " + profile + ")"}
+				}
+			}
+		}
+		if !found {
+			return nil, unknownProfileErr(profile, profileNames(layers))
+		}
+		byKey[keyProfile] = &jira4claude.ConfigSetting{Key: keyProfile, Value: profile, Origin: profileOrigin}
+	}
+
+	for _, setting := range envSettings(getenv) {
+		byKey[setting.Key] = setting
+	}
+
+	settings := make([]*jira4claude.ConfigSetting, 0, len(byKey))
+	for _, key := range append([]string{keyProfile}, valueKeys()...) {
+		if setting, ok := byKey[key]; ok {
+			settings = append(settings, setting)
+		}
+	}
+	return settings, nil
+}
+
+// readConfigFile reads and parses the YAML file at path.
+func readConfigFile(path string) (*configFile, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -50,46 +332,192 @@ func LoadConfig(path string) (*jira4claude.Config, error) {
 			Inner:   err,
 		}
 	}
+	return &cf, nil
+}
 
-	if cf.Server == "" {
-		return nil, validationErr("config file missing required field: server")
+// unknownProfileErr lists the available profiles so the caller can self-correct.
+func unknownProfileErr(name string, available []string) error {
+	if len(available) == 0 {
+		return validationErr("unknown profile " + strconv.Quote(name) + "; config file defines no profiles")
 	}
-	if cf.Project == "" {
-		return nil, validationErr("config file missing required field: project")
+	return validationErr("unknown profile " + strconv.Quote(name) + "; available: " + strings.Join(available, ", "))
+}
+
+// profileNames returns the names of the profiles defined in any layer, sorted.
+func profileNames(layers []layer) []string {
+	var names []string
+	for _, l := range layers {
+		for name := range l.file.Profiles {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
 	}
+	slices.Sort(names)
+	return names
+}
 
-	return &jira4claude.Config{
-		Server:  cf.Server,
-		Project: cf.Project,
-	}, nil
+// expandHome replaces a leading ~/ with the user's home directory.
+func expandHome(path string) string {
+	rest, ok := strings.CutPrefix(path, "~/")
+	if !ok {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, rest)
 }
 
-// DiscoverConfig searches for config files in standard locations.
-// Returns the path to the first config file found.
-// Search order: workDir/.jira4claude.yaml, homeDir/.jira4claude.yaml
-func DiscoverConfig(workDir, homeDir string) (string, error) {
-	localPath := filepath.Join(workDir, configFileName)
-	if _, err := os.Stat(localPath); err == nil {
-		return localPath, nil
+// Profiles returns the named profiles defined in the YAML files at paths
+// (lowest precedence first), sorted by name. Each profile shows its effective
+// values: profile fields merged across files over the merged top-level values.
+func Profiles(paths []string) ([]*jira4claude.Profile, error) {
+	layers, err := readLayers(paths)
+	if err != nil {
+		return nil, err
 	}
 
-	globalPath := filepath.Join(homeDir, configFileName)
-	if _, err := os.Stat(globalPath); err == nil {
-		return globalPath, nil
+	var base profileFile
+	var defaultProfile string
+	for _, l := range layers {
+		mergeProfile(&base, l.file.profileFile)
+		if l.file.DefaultProfile != "" {
+			defaultProfile = l.file.DefaultProfile
+		}
 	}
 
-	return "", notFoundErr("no config file found; searched: ./"+configFileName+", ~/"+configFileName+"\nRun: j4c init --server=URL --project=KEY", nil)
+	names := profileNames(layers)
+	profiles := make([]*jira4claude.Profile, 0, len(names))
+	for _, name := range names {
+		merged := base
+		for _, l := range layers {
+			if pf, ok := l.file.Profiles[name]; ok {
+				mergeProfile(&merged, pf)
+			}
+		}
+		profiles = append(profiles, &jira4claude.Profile{
+			Name:    name,
+			Server:  merged.Server,
+			Project: merged.Project,
+			Netrc:   merged.Netrc,
+			Output:  merged.Output,
+			Default: name == defaultProfile,
+		})
+	}
+	return profiles, nil
+}
+
+// mergeProfile overrides the connection fields of dst with the non-empty fields of src.
+func mergeProfile(dst *profileFile, src profileFile) {
+	if src.Server != "" {
+		dst.Server = src.Server
+	}
+	if src.Project != "" {
+		dst.Project = src.Project
+	}
+	if src.Netrc != "" {
+		dst.Netrc = src.Netrc
+	}
+	if src.Output != "" {
+		dst.Output = src.Output
+	}
+}
+
+// DiscoverConfig returns the config files that apply to workDir, ordered from
+// lowest to highest precedence:
+//
+//  1. homeDir/.jira4claude.yaml (personal defaults)
+//  2. .jira4claude.yaml in each directory from the repository root down to workDir
+//  3. .jira4claude.local.yaml next to each of those (local overrides)
+//
+// The repository root is the nearest ancestor with a .git directory, so
+// subdirectories and worktrees (whose .git is a file) pick up the repository's
+// config. Outside a repository only workDir is searched.
+func DiscoverConfig(workDir, homeDir string) ([]string, error) {
+	var paths []string
+	homePath := filepath.Join(homeDir, configFileName)
+	if fileExists(homePath) {
+		paths = append(paths, homePath)
+	}
+
+	for _, dir := range git.SearchDirs(workDir) {
+		for _, name := range []string{configFileName, localConfigFileName} {
+			path := filepath.Join(dir, name)
+			if path != homePath && fileExists(path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, notFoundErr("no config file found; searched: ./"+configFileName+" up to the repository root, ~/"+configFileName+"\nRun: j4c init --server=URL --project=KEY, or set "+envVar(keyServer)+" and "+envVar(keyProject), nil)
+	}
+	return paths, nil
+}
+
+// fileExists reports whether path exists.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
 }
 
 // Compile-time interface verification.
 var _ jira4claude.ConfigService = (*Service)(nil)
 
 // Service implements jira4claude.ConfigService using YAML configuration files.
-type Service struct{}
+// Values set in J4C_* environment variables (e.g. J4C_SERVER, J4C_PROJECT,
+// J4C_CREATE_LABELS) override the files and the selected profile.
+type Service struct {
+	getenv func(string) string
+}
 
-// NewService creates a new Service.
+// NewService creates a new Service that reads overrides from the process
+// environment.
 func NewService() *Service {
-	return &Service{}
+	return &Service{getenv: os.Getenv}
+}
+
+// NewServiceWithEnv creates a new Service that reads overrides through getenv.
+// This is useful for testing.
+func NewServiceWithEnv(getenv func(string) string) *Service {
+	return &Service{getenv: getenv}
+}
+
+// Profiles returns the named profiles defined in the config files at paths.
+func (s *Service) Profiles(paths []string) ([]*jira4claude.Profile, error) {
+	return Profiles(paths)
+}
+
+// Show returns the effective settings merged from the config files at paths.
+func (s *Service) Show(paths []string, profile string) ([]*jira4claude.ConfigSetting, error) {
+	return resolve(paths, profile, s.getenv)
+}
+
+// Load returns the config merged from the config files at paths.
+func (s *Service) Load(paths []string, profile string) (*jira4claude.Config, error) {
+	return loadLayers(paths, profile, s.getenv)
+}
+
+// Get returns the effective value of a single config key.
+func (s *Service) Get(paths []string, profile, key string) (*jira4claude.ConfigSetting, error) {
+	return get(paths, profile, key, s.getenv)
+}
+
+// Set sets a config key in the file at path.
+func (s *Service) Set(path, key, value string) error {
+	return Set(path, key, value)
+}
+
+// Unset removes a config key from the file at path.
+func (s *Service) Unset(path, key string) error {
+	return Unset(path, key)
+}
+
+// Validate checks the config files at paths for schema errors.
+func (s *Service) Validate(paths []string, profile string) error {
+	return validate(paths, profile, s.getenv)
 }
 
 // Init creates a new config file in the given directory.
@@ -132,10 +560,10 @@ func validateConfigDoesNotExist(configPath string) error {
 
 // createConfigFile writes a new config file with the given server and project.
 func createConfigFile(configPath, server, project string) error {
-	cf := configFile{
+	cf := configFile{profileFile: profileFile{
 		Server:  server,
 		Project: project,
-	}
+	}}
 	content, err := yaml.Marshal(&cf)
 	if err != nil {
 		return internalErr("failed to marshal config", err)
@@ -21,7 +21,7 @@ func TestLoadConfig(t *testing.T) {
 server: https://example.atlassian.net
 project: TEST
 `)
-		cfg, err := yaml.LoadConfig(path)
+		cfg, err := yaml.LoadConfig(path, "")
 
 		require.NoError(t, err)
 		assert.Equal(t, "https://example.atlassian.net", cfg.Server)
@@ -31,7 +31,7 @@ project: TEST
 	t.Run("returns error for nonexistent file", func(t *testing.T) {
 		t.Parallel()
 
-		_, err := yaml.LoadConfig("/nonexistent/path/to/config.yaml")
+		_, err := yaml.LoadConfig("/nonexistent/path/to/config.yaml", "")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
@@ -44,7 +44,7 @@ project: TEST
 server: [invalid
 project: TEST
 `)
-		_, err := yaml.LoadConfig(path)
+		_, err := yaml.LoadConfig(path, "")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
@@ -58,7 +58,7 @@ project: TEST
 		path := filepath.Join(dir, "config.yaml")
 		require.NoError(t, os.Mkdir(path, 0o755))
 
-		_, err := yaml.LoadConfig(path)
+		_, err := yaml.LoadConfig(path, "")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.EInternal, jira4claude.ErrorCode(err))
@@ -70,7 +70,7 @@ project: TEST
 		path := writeConfigFile(t, `
 project: TEST
 `)
-		_, err := yaml.LoadConfig(path)
+		_, err := yaml.LoadConfig(path, "")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
@@ -83,7 +83,7 @@ project: TEST
 		path := writeConfigFile(t, `
 server: https://example.atlassian.net
 `)
-		_, err := yaml.LoadConfig(path)
+		_, err := yaml.LoadConfig(path, "")
 
 		require.Error(t, err)
 		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
@@ -91,6 +91,134 @@ server: https://example.atlassian.net
 	})
 }
 
+func TestLoadConfig_Profiles(t *testing.T) {
+	t.Parallel()
+
+	const config = `
+server: https://one.atlassian.net
+project: ONE
+default_profile: ops
+profiles:
+  ops:
+    project: OPS
+  other:
+    server: https://two.atlassian.net
+    project: TWO
+    netrc: /etc/j4c/netrc
+    output: json
+`
+
+	t.Run("uses default profile when none is selected", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, err := yaml.LoadConfig(writeConfigFile(t, config), "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "ops", cfg.Profile)
+		assert.Equal(t, "https://one.atlassian.net", cfg.Server)
+		assert.Equal(t, "OPS", cfg.Project)
+	})
+
+	t.Run("selected profile overrides top-level values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, err := yaml.LoadConfig(writeConfigFile(t, config), "other")
+
+		require.NoError(t, err)
+		assert.Equal(t, "other", cfg.Profile)
+		assert.Equal(t, "https://two.atlassian.net", cfg.Server)
+		assert.Equal(t, "TWO", cfg.Project)
+		assert.Equal(t, "/etc/j4c/netrc", cfg.Netrc)
+		assert.Equal(t, jira4claude.OutputJSON, cfg.Output)
+	})
+
+	t.Run("returns error listing available profiles for unknown profile", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := yaml.LoadConfig(writeConfigFile(t, config), "missing")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), `unknown profile "missing"`)
+		assert.Contains(t, err.Error(), "ops, other")
+	})
+
+	t.Run("returns error for invalid output", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+output: xml
+`)
+		_, err := yaml.LoadConfig(path, "")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "output")
+	})
+
+	t.Run("expands home directory in netrc path", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+netrc: ~/.netrc-work
+`)
+		cfg, err := yaml.LoadConfig(path, "")
+
+		require.NoError(t, err)
+		home, err := os.UserHomeDir()
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(home, ".netrc-work"), cfg.Netrc)
+	})
+}
+
+func TestProfiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns profiles sorted by name with inherited values", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://one.atlassian.net
+project: ONE
+default_profile: ops
+profiles:
+  ops:
+    project: OPS
+  alpha:
+    server: https://two.atlassian.net
+    project: ALPHA
+`)
+		profiles, err := yaml.NewService().Profiles([]string{path})
+
+		require.NoError(t, err)
+		require.Len(t, profiles, 2)
+		assert.Equal(t, "alpha", profiles[0].Name)
+		assert.Equal(t, "https://two.atlassian.net", profiles[0].Server)
+		assert.False(t, profiles[0].Default)
+		assert.Equal(t, "ops", profiles[1].Name)
+		assert.Equal(t, "https://one.atlassian.net", profiles[1].Server)
+		assert.Equal(t, "OPS", profiles[1].Project)
+		assert.True(t, profiles[1].Default)
+	})
+
+	t.Run("returns empty list when no profiles are defined", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+`)
+		profiles, err := yaml.Profiles([]string{path})
+
+		require.NoError(t, err)
+		assert.Empty(t, profiles)
+	})
+}
+
 // writeConfigFile creates a temporary YAML config file and returns its path.
 func writeConfigFile(t *testing.T, content string) string {
 	t.Helper()
@@ -116,10 +244,10 @@ project: TEST
 		localPath := filepath.Join(workDir, ".jira4claude.yaml")
 		require.NoError(t, os.WriteFile(localPath, []byte(validConfig), 0o644))
 
-		path, err := yaml.DiscoverConfig(workDir, homeDir)
+		paths, err := yaml.DiscoverConfig(workDir, homeDir)
 
 		require.NoError(t, err)
-		assert.Equal(t, localPath, path)
+		assert.Equal(t, []string{localPath}, paths)
 	})
 
 	t.Run("returns global config when local does not exist", func(t *testing.T) {
@@ -130,10 +258,10 @@ project: TEST
 		globalPath := filepath.Join(homeDir, ".jira4claude.yaml")
 		require.NoError(t, os.WriteFile(globalPath, []byte(validConfig), 0o644))
 
-		path, err := yaml.DiscoverConfig(workDir, homeDir)
+		paths, err := yaml.DiscoverConfig(workDir, homeDir)
 
 		require.NoError(t, err)
-		assert.Equal(t, globalPath, path)
+		assert.Equal(t, []string{globalPath}, paths)
 	})
 
 	t.Run("returns error when no config exists with clear fix command", func(t *testing.T) {
@@ -153,7 +281,7 @@ project: TEST
 		assert.Contains(t, err.Error(), "--project")
 	})
 
-	t.Run("prefers local config over global when both exist", func(t *testing.T) {
+	t.Run("orders global config before local config when both exist", func(t *testing.T) {
 		t.Parallel()
 
 		workDir := t.TempDir()
@@ -163,10 +291,213 @@ project: TEST
 		require.NoError(t, os.WriteFile(localPath, []byte(validConfig), 0o644))
 		require.NoError(t, os.WriteFile(globalPath, []byte(validConfig), 0o644))
 
-		path, err := yaml.DiscoverConfig(workDir, homeDir)
+		paths, err := yaml.DiscoverConfig(workDir, homeDir)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{globalPath, localPath}, paths)
+	})
+
+	t.Run("searches upward to the repository root", func(t *testing.T) {
+		t.Parallel()
+
+		repo := t.TempDir()
+		homeDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(repo, ".git"), 0o755))
+		workDir := filepath.Join(repo, "internal", "pkg")
+		require.NoError(t, os.MkdirAll(workDir, 0o755))
+		repoPath := filepath.Join(repo, ".jira4claude.yaml")
+		pkgPath := filepath.Join(workDir, ".jira4claude.yaml")
+		require.NoError(t, os.WriteFile(repoPath, []byte(validConfig), 0o644))
+		require.NoError(t, os.WriteFile(pkgPath, []byte(validConfig), 0o644))
+
+		paths, err := yaml.DiscoverConfig(workDir, homeDir)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{repoPath, pkgPath}, paths)
+	})
+
+	t.Run("finds repository config from a worktree", func(t *testing.T) {
+		t.Parallel()
+
+		repo := t.TempDir()
+		homeDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(repo, ".git"), 0o755))
+		worktree := filepath.Join(repo, ".worktrees", "J4C-42")
+		require.NoError(t, os.MkdirAll(worktree, 0o755))
+		// Worktrees have a .git file pointing at the main repository
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: ../../.git/worktrees/J4C-42\n"), 0o644))
+		repoPath := filepath.Join(repo, ".jira4claude.yaml")
+		require.NoError(t, os.WriteFile(repoPath, []byte(validConfig), 0o644))
+
+		paths, err := yaml.DiscoverConfig(worktree, homeDir)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{repoPath}, paths)
+	})
+
+	t.Run("does not search above workDir outside a repository", func(t *testing.T) {
+		t.Parallel()
+
+		parent := t.TempDir()
+		homeDir := t.TempDir()
+		workDir := filepath.Join(parent, "child")
+		require.NoError(t, os.Mkdir(workDir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(parent, ".jira4claude.yaml"), []byte(validConfig), 0o644))
+
+		_, err := yaml.DiscoverConfig(workDir, homeDir)
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("orders local overrides after the config in the same directory", func(t *testing.T) {
+		t.Parallel()
+
+		workDir := t.TempDir()
+		homeDir := t.TempDir()
+		configPath := filepath.Join(workDir, ".jira4claude.yaml")
+		overridePath := filepath.Join(workDir, ".jira4claude.local.yaml")
+		require.NoError(t, os.WriteFile(configPath, []byte(validConfig), 0o644))
+		require.NoError(t, os.WriteFile(overridePath, []byte("project: MINE\n"), 0o644))
+
+		paths, err := yaml.DiscoverConfig(workDir, homeDir)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{configPath, overridePath}, paths)
+	})
+}
+
+func TestLoadLayers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("later files override earlier ones", func(t *testing.T) {
+		t.Parallel()
+
+		home := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: HOME
+output: json
+`)
+		repo := writeConfigFile(t, `
+project: REPO
+`)
+		cfg, err := yaml.LoadLayers([]string{home, repo}, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.atlassian.net", cfg.Server)
+		assert.Equal(t, "REPO", cfg.Project)
+		assert.Equal(t, jira4claude.OutputJSON, cfg.Output)
+	})
+
+	t.Run("merges profiles across files", func(t *testing.T) {
+		t.Parallel()
+
+		home := writeConfigFile(t, `
+server: https://one.atlassian.net
+project: ONE
+profiles:
+  partner:
+    server: https://partner.atlassian.net
+`)
+		repo := writeConfigFile(t, `
+default_profile: partner
+profiles:
+  partner:
+    project: PART
+`)
+		cfg, err := yaml.LoadLayers([]string{home, repo}, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "partner", cfg.Profile)
+		assert.Equal(t, "https://partner.atlassian.net", cfg.Server)
+		assert.Equal(t, "PART", cfg.Project)
+	})
+
+	t.Run("validates required fields after merging", func(t *testing.T) {
+		t.Parallel()
+
+		repo := writeConfigFile(t, `
+project: REPO
+`)
+		_, err := yaml.LoadLayers([]string{repo}, "")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "server")
+	})
+
+	t.Run("loads create defaults with profile overrides", func(t *testing.T) {
+		t.Parallel()
+
+		home := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+create:
+  type: Story
+  labels: [backend, api]
+  watchers: [me]
+  templates:
+    Bug: "## Steps"
+    Story: "## Goal"
+profiles:
+  ops:
+    create:
+      type: Bug
+      templates:
+        Bug: "## Impact"
+`)
+		cfg, err := yaml.LoadLayers([]string{home}, "ops")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Bug", cfg.Create.Type)
+		assert.Equal(t, []string{"backend", "api"}, cfg.Create.Labels)
+		assert.Equal(t, []string{"me"}, cfg.Create.Watchers)
+		assert.Equal(t, map[string]string{"Bug": "## Impact", "Story": "## Goal"}, cfg.Create.Templates)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports the file each value came from", func(t *testing.T) {
+		t.Parallel()
+
+		home := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: HOME
+`)
+		repo := writeConfigFile(t, `
+project: REPO
+profiles:
+  web:
+    output: json
+`)
+		settings, err := yaml.NewService().Show([]string{home, repo}, "web")
+
+		require.NoError(t, err)
+		assert.Equal(t, []*jira4claude.ConfigSetting{
+			{Key: "profile", Value: "web", Origin: "--profile"},
+			{Key: "server", Value: "https://example.atlassian.net", Origin: home},
+			{Key: "project", Value: "REPO", Origin: repo},
+			{Key: "output", Value: "json", Origin: repo + " (profile web)"},
+		}, settings)
+	})
+
+	t.Run("reports the file that selected the default profile", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+default_profile: web
+profiles:
+  web: {}
+`)
+		settings, err := yaml.Resolve([]string{path}, "")
 
 		require.NoError(t, err)
-		assert.Equal(t, localPath, path)
+		require.NotEmpty(t, settings)
+		assert.Equal(t, &jira4claude.ConfigSetting{Key: "profile", Value: "web", Origin: path}, settings[0])
 	})
 }
 
@@ -311,7 +642,7 @@ func TestInit(t *testing.T) {
 
 		// The created file should be valid YAML that can be parsed back
 		configPath := filepath.Join(dir, ".jira4claude.yaml")
-		cfg, err := yaml.LoadConfig(configPath)
+		cfg, err := yaml.LoadConfig(configPath, "")
 		require.NoError(t, err, "created config should be valid YAML")
 		assert.Equal(t, server, cfg.Server, "server should round-trip correctly")
 		assert.Equal(t, project, cfg.Project, "project should round-trip correctly")
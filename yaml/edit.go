@@ -0,0 +1,250 @@
+package yaml
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+	"gopkg.in/yaml.v3"
+)
+
+// keyDefaultProfile is the top-level key naming the default profile.
+const keyDefaultProfile = "default_profile"
+
+// keyProfiles is the top-level key holding the named profiles.
+const keyProfiles = "profiles"
+
+// parseKey splits a dotted config key into YAML path segments and checks it
+// against the known keys: the value keys, default_profile, and
+// profiles.NAME.KEY for any value key.
+func parseKey(key string) ([]string, error) {
+	if slices.Contains(valueKeys(), key) || key == keyDefaultProfile {
+		return strings.Split(key, "."), nil
+	}
+	if rest, ok := strings.CutPrefix(key, keyProfiles+"."); ok {
+		name, valueKey, ok := strings.Cut(rest, ".")
+		if ok && name != "" && slices.Contains(valueKeys(), valueKey) {
+			return append([]string{keyProfiles, name}, strings.Split(valueKey, ".")...), nil
+		}
+	}
+	return nil, validationErr("unknown config key " + strconv.Quote(key) + "; valid keys: " +
+		strings.Join(valueKeys(), ", ") + ", " + keyDefaultProfile + ", " + keyProfiles + ".NAME.KEY")
+}
+
+// Get returns the effective value of key merged from the YAML files at paths.
+// Value keys resolve through the selected profile like LoadLayers; other keys
+// are read verbatim, with later files taking precedence.
+func Get(paths []string, profile, key string) (*jira4claude.ConfigSetting, error) {
+	return get(paths, profile, key, nil)
+}
+
+// get is Get with value keys overridden by environment variables read
+// through getenv.
+func get(paths []string, profile, key string, getenv func(string) string) (*jira4claude.ConfigSetting, error) {
+	segs, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if segs[0] != keyProfiles && key != keyDefaultProfile {
+		settings, err := resolve(paths, profile, getenv)
+		if err != nil {
+			return nil, err
+		}
+		for _, setting := range settings {
+			if setting.Key == key {
+				return setting, nil
+			}
+		}
+		return nil, notFoundErr(key+" is not set", nil)
+	}
+
+	layers, err := readLayers(paths)
+	if err != nil {
+		return nil, err
+	}
+	var found *jira4claude.ConfigSetting
+	for _, l := range layers {
+		var v string
+		if key == keyDefaultProfile {
+			v = l.file.DefaultProfile
+		} else {
+			v = l.file.Profiles[segs[1]].value(strings.Join(segs[2:], "."))
+		}
+		if v != "" {
+			found = &jira4claude.ConfigSetting{Key: key, Value: v, Origin: l.path}
+		}
+	}
+	if found == nil {
+		return nil, notFoundErr(key+" is not set", nil)
+	}
+	return found, nil
+}
+
+// Set sets key to value in the YAML file at path, creating the file if it
+// does not exist. Comments and unrelated keys in the file are preserved.
+func Set(path, key, value string) error {
+	segs, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return validationErr("value for " + key + " must not be empty; use config unset to remove it")
+	}
+	valueKey := key
+	if segs[0] == keyProfiles {
+		valueKey = strings.Join(segs[2:], ".")
+	}
+	if valueKey == keyOutput && value != jira4claude.OutputMarkdown && value != jira4claude.OutputJSON {
+		return validationErr("invalid output " + strconv.Quote(value) + "; must be markdown or json")
+	}
+
+	doc, err := readDocument(path, true)
+	if err != nil {
+		return err
+	}
+	node := doc.Content[0]
+	for _, seg := range segs[:len(segs)-1] {
+		node = mappingChild(node, seg)
+	}
+	if isListKey(valueKey) {
+		setList(node, segs[len(segs)-1], splitList(value))
+	} else {
+		setScalar(node, segs[len(segs)-1], value)
+	}
+	return writeDocument(path, doc)
+}
+
+// Unset removes key from the YAML file at path. Profiles left empty are
+// removed too. Returns ENotFound if the key is not set in the file.
+func Unset(path, key string) error {
+	segs, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+
+	doc, err := readDocument(path, false)
+	if err != nil {
+		return err
+	}
+	if !removeKey(doc.Content[0], segs) {
+		return notFoundErr(key+" is not set in "+path, nil)
+	}
+	return writeDocument(path, doc)
+}
+
+// readDocument parses the YAML file at path into a document node whose
+// root is a mapping. A missing or empty file yields an empty mapping when
+// create is set.
+func readDocument(path string, create bool) (*yaml.Node, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, internalErr("failed to read config file", err)
+		}
+		if !create {
+			return nil, notFoundErr("config file not found", err)
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, &jira4claude.Error{
+			Code:    jira4claude.EValidation,
+			Message: "invalid YAML in config file",
+			Inner:   err,
+		}
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, validationErr("config file must contain a YAML mapping")
+	}
+	return &doc, nil
+}
+
+// writeDocument writes doc to path with the same permissions as init.
+func writeDocument(path string, doc *yaml.Node) error {
+	content, err := yaml.Marshal(doc)
+	if err != nil {
+		return internalErr("failed to marshal config", err)
+	}
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return internalErr("failed to write config file", err)
+	}
+	return nil
+}
+
+// mappingChild returns the mapping stored under key, creating it if missing.
+// A non-mapping value under key is replaced.
+func mappingChild(node *yaml.Node, key string) *yaml.Node {
+	if i := keyIndex(node, key); i >= 0 {
+		child := node.Content[i+1]
+		if child.Kind != yaml.MappingNode {
+			*child = yaml.Node{Kind: yaml.MappingNode}
+		}
+		return child
+	}
+	child := &yaml.Node{Kind: yaml.MappingNode}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, child)
+	return child
+}
+
+// setScalar sets key to a string value in a mapping node.
+func setScalar(node *yaml.Node, key, value string) {
+	setValue(node, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
+
+// setList sets key to a flow-style list of strings in a mapping node.
+func setList(node *yaml.Node, key string, items []string) {
+	list := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+	for _, item := range items {
+		list.Content = append(list.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: item})
+	}
+	setValue(node, key, list)
+}
+
+// setValue sets key to value in a mapping node, keeping any line comment
+// attached to the old value.
+func setValue(node *yaml.Node, key string, value *yaml.Node) {
+	if i := keyIndex(node, key); i >= 0 {
+		value.LineComment = node.Content[i+1].LineComment
+		node.Content[i+1] = value
+		return
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// removeKey removes the value at segs, dropping mappings left empty along the
+// way. Reports whether anything was removed.
+func removeKey(node *yaml.Node, segs []string) bool {
+	i := keyIndex(node, segs[0])
+	if i < 0 {
+		return false
+	}
+	if len(segs) > 1 {
+		child := node.Content[i+1]
+		if child.Kind != yaml.MappingNode || !removeKey(child, segs[1:]) {
+			return false
+		}
+		if len(child.Content) > 0 {
+			return true
+		}
+	}
+	node.Content = slices.Delete(node.Content, i, i+2)
+	return true
+}
+
+// keyIndex returns the index of key in a mapping node's content, or -1.
+func keyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,217 @@
+package yaml_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns effective value with origin", func(t *testing.T) {
+		t.Parallel()
+
+		home := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: HOME
+`)
+		repo := writeConfigFile(t, `
+project: REPO
+`)
+		setting, err := yaml.Get([]string{home, repo}, "", "project")
+
+		require.NoError(t, err)
+		assert.Equal(t, &jira4claude.ConfigSetting{Key: "project", Value: "REPO", Origin: repo}, setting)
+	})
+
+	t.Run("returns profile keys verbatim", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+profiles:
+  web:
+    project: WEB
+`)
+		setting, err := yaml.NewService().Get([]string{path}, "", "profiles.web.project")
+
+		require.NoError(t, err)
+		assert.Equal(t, "WEB", setting.Value)
+	})
+
+	t.Run("returns not found for unset key", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+`)
+		_, err := yaml.Get([]string{path}, "", "netrc")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns validation error for unknown key", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+`)
+		_, err := yaml.Get([]string{path}, "", "colour")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "valid keys")
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replaces value and preserves comments", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `# Team config
+server: https://example.atlassian.net
+project: TEST # main project
+`)
+		err := yaml.Set(path, "project", "OTHER")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# Team config")
+		assert.Contains(t, string(content), "project: OTHER # main project")
+		cfg, err := yaml.LoadConfig(path, "")
+		require.NoError(t, err)
+		assert.Equal(t, "OTHER", cfg.Project)
+	})
+
+	t.Run("creates profile mapping as needed", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+`)
+		err := yaml.NewService().Set(path, "profiles.web.project", "WEB")
+
+		require.NoError(t, err)
+		cfg, err := yaml.LoadConfig(path, "web")
+		require.NoError(t, err)
+		assert.Equal(t, "WEB", cfg.Project)
+	})
+
+	t.Run("writes list keys as a flow sequence", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), ".jira4claude.yaml")
+
+		err := yaml.Set(path, "create.labels", "backend, api")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "create:\n    labels: [backend, api]\n", string(content))
+	})
+
+	t.Run("creates the file when it does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), ".jira4claude.local.yaml")
+
+		err := yaml.Set(path, "output", "json")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "output: json\n", string(content))
+	})
+
+	t.Run("returns validation error for invalid output", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, "server: https://example.atlassian.net\n")
+
+		err := yaml.Set(path, "output", "xml")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns validation error for unknown key", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, "server: https://example.atlassian.net\n")
+
+		err := yaml.Set(path, "profiles.web.colour", "blue")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+	})
+}
+
+func TestUnset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes key", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: https://example.atlassian.net
+project: TEST
+output: json
+`)
+		err := yaml.NewService().Unset(path, "output")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "output")
+	})
+
+	t.Run("removes profiles left empty", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: https://example.atlassian.net
+project: TEST
+profiles:
+  web:
+    project: WEB
+`)
+		err := yaml.Unset(path, "profiles.web.project")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "profiles")
+	})
+
+	t.Run("returns not found when key is not set", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, "server: https://example.atlassian.net\n")
+
+		err := yaml.Unset(path, "project")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+
+	t.Run("returns not found when file does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		err := yaml.Unset(filepath.Join(t.TempDir(), "missing.yaml"), "project")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.ENotFound, jira4claude.ErrorCode(err))
+	})
+}
@@ -0,0 +1,35 @@
+package yaml
+
+import (
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+)
+
+// envPrefix starts the names of the environment variables that override
+// config values.
+const envPrefix = "J4C_"
+
+// envVar returns the environment variable that overrides key: the key
+// upper-cased with dots replaced by underscores, e.g. J4C_CREATE_LABELS for
+// create.labels.
+func envVar(key string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// envSettings returns the value keys set in the environment, with an origin
+// naming the variable. Empty variables are treated as unset. A nil getenv
+// yields no settings.
+func envSettings(getenv func(string) string) []*jira4claude.ConfigSetting {
+	if getenv == nil {
+		return nil
+	}
+	var settings []*jira4claude.ConfigSetting
+	for _, key := range valueKeys() {
+		name := envVar(key)
+		if v := getenv(name); v != "" {
+			settings = append(settings, &jira4claude.ConfigSetting{Key: key, Value: v, Origin: "env " + name})
+		}
+	}
+	return settings
+}
@@ -0,0 +1,103 @@
+package yaml_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mapEnv returns a getenv function backed by vars.
+func mapEnv(vars map[string]string) func(string) string {
+	return func(key string) string {
+		return vars[key]
+	}
+}
+
+func TestService_EnvOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("loads config from the environment alone", func(t *testing.T) {
+		t.Parallel()
+
+		svc := yaml.NewServiceWithEnv(mapEnv(map[string]string{
+			"J4C_SERVER":        "https://env.atlassian.net",
+			"J4C_PROJECT":       "ENV",
+			"J4C_CREATE_LABELS": "ci, bot",
+		}))
+
+		cfg, err := svc.Load(nil, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://env.atlassian.net", cfg.Server)
+		assert.Equal(t, "ENV", cfg.Project)
+		assert.Equal(t, []string{"ci", "bot"}, cfg.Create.Labels)
+	})
+
+	t.Run("environment overrides files and the selected profile", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+profiles:
+  web:
+    project: WEB
+`)
+		svc := yaml.NewServiceWithEnv(mapEnv(map[string]string{"J4C_PROJECT": "CI"}))
+
+		settings, err := svc.Show([]string{path}, "web")
+
+		require.NoError(t, err)
+		assert.Equal(t, []*jira4claude.ConfigSetting{
+			{Key: "profile", Value: "web", Origin: "--profile"},
+			{Key: "server", Value: "https://example.atlassian.net", Origin: path},
+			{Key: "project", Value: "CI", Origin: "env J4C_PROJECT"},
+		}, settings)
+	})
+
+	t.Run("ignores empty variables", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+`)
+		svc := yaml.NewServiceWithEnv(mapEnv(map[string]string{"J4C_PROJECT": ""}))
+
+		setting, err := svc.Get([]string{path}, "", "project")
+
+		require.NoError(t, err)
+		assert.Equal(t, "TEST", setting.Value)
+		assert.Equal(t, path, setting.Origin)
+	})
+
+	t.Run("reports missing fields naming the variable", func(t *testing.T) {
+		t.Parallel()
+
+		svc := yaml.NewServiceWithEnv(mapEnv(map[string]string{"J4C_SERVER": "https://env.atlassian.net"}))
+
+		_, err := svc.Load(nil, "")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), "J4C_PROJECT")
+	})
+
+	t.Run("validates environment values", func(t *testing.T) {
+		t.Parallel()
+
+		svc := yaml.NewServiceWithEnv(mapEnv(map[string]string{
+			"J4C_SERVER":  "https://env.atlassian.net",
+			"J4C_PROJECT": "ENV",
+			"J4C_OUTPUT":  "xml",
+		}))
+
+		err := svc.Validate(nil, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "env J4C_OUTPUT: output must be markdown or json")
+	})
+}
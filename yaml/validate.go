@@ -0,0 +1,210 @@
+package yaml
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/fwojciec/jira4claude"
+	"gopkg.in/yaml.v3"
+)
+
+// projectKeyPattern matches Jira project keys (e.g., "J4C", "WEB_2").
+//
+//nolint:gochecknoglobals // Compiled regex is immutable
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+
+// Validate checks each YAML file at paths for unknown keys and invalid values,
+// then checks that the merged config for profile is complete. It returns nil
+// for a valid config, or an EValidation error listing every problem with the
+// file and line it was found on.
+func Validate(paths []string, profile string) error {
+	return validate(paths, profile, nil)
+}
+
+// validate is Validate with environment overrides read through getenv.
+// Override values are checked like file values.
+func validate(paths []string, profile string, getenv func(string) string) error {
+	var problems []string
+	for _, path := range paths {
+		problems = append(problems, validateFile(path)...)
+	}
+	problems = append(problems, validateEnv(getenv)...)
+	if len(problems) == 0 {
+		if _, err := loadLayers(paths, profile, getenv); err != nil {
+			problems = append(problems, jira4claude.ErrorMessage(err))
+		} else {
+			problems = append(problems, validateDefaultProfile(paths)...)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return validationErr("invalid config: " + strings.Join(problems, "; "))
+}
+
+// validateFile checks the keys and values of a single config file.
+func validateFile(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []string{path + ": " + err.Error()}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return []string{path + ": invalid YAML: " + err.Error()}
+	}
+	if doc.Kind == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return []string{fmt.Sprintf("%s:%d: config must be a mapping", path, root.Line)}
+	}
+
+	var problems []string
+	addf := func(node *yaml.Node, format string, args ...any) {
+		problems = append(problems, fmt.Sprintf("%s:%d: ", path, node.Line)+fmt.Sprintf(format, args...))
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		switch {
+		case key.Value == keyProfiles:
+			validateProfiles(value, addf)
+		case key.Value == keyDefaultProfile:
+			validateScalar(key.Value, value, addf)
+		default:
+			validateEntry(key, value, "", addf)
+		}
+	}
+	return problems
+}
+
+// validateEnv checks the values of the environment overrides.
+func validateEnv(getenv func(string) string) []string {
+	var problems []string
+	for _, setting := range envSettings(getenv) {
+		addf := func(_ *yaml.Node, format string, args ...any) {
+			problems = append(problems, setting.Origin+": "+fmt.Sprintf(format, args...))
+		}
+		validateValue(setting.Key, &yaml.Node{Kind: yaml.ScalarNode, Value: setting.Value}, addf)
+	}
+	return problems
+}
+
+// validateProfiles checks the profiles mapping.
+func validateProfiles(node *yaml.Node, addf func(*yaml.Node, string, ...any)) {
+	if node.Kind != yaml.MappingNode {
+		addf(node, "profiles must be a mapping of profile names")
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name, profile := node.Content[i], node.Content[i+1]
+		if profile.Kind != yaml.MappingNode {
+			addf(profile, "profile %q must be a mapping", name.Value)
+			continue
+		}
+		for j := 0; j+1 < len(profile.Content); j += 2 {
+			validateEntry(profile.Content[j], profile.Content[j+1], fmt.Sprintf(" in profile %q", name.Value), addf)
+		}
+	}
+}
+
+// validateEntry checks a key that may appear both at the top level and in a
+// profile. The where suffix locates the key in problem messages.
+func validateEntry(key, value *yaml.Node, where string, addf func(*yaml.Node, string, ...any)) {
+	switch {
+	case key.Value == "create":
+		validateCreate(value, where, addf)
+	case slices.Contains(valueKeys(), key.Value):
+		validateValue(key.Value, value, addf)
+	default:
+		addf(key, "unknown key %q%s", key.Value, where)
+	}
+}
+
+// validateCreate checks the create defaults mapping.
+func validateCreate(node *yaml.Node, where string, addf func(*yaml.Node, string, ...any)) {
+	if node.Kind != yaml.MappingNode {
+		addf(node, "create must be a mapping%s", where)
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		fullKey := "create." + key.Value
+		switch {
+		case fullKey == keyCreateTemplates:
+			if value.Kind != yaml.MappingNode {
+				addf(value, "%s must be a mapping of issue type to markdown", fullKey)
+				continue
+			}
+			for j := 1; j < len(value.Content); j += 2 {
+				validateScalar(fullKey+"."+value.Content[j-1].Value, value.Content[j], addf)
+			}
+		case isListKey(fullKey):
+			if value.Kind != yaml.SequenceNode {
+				addf(value, "%s must be a list", fullKey)
+				continue
+			}
+			for _, item := range value.Content {
+				validateScalar(fullKey+" item", item, addf)
+			}
+		case slices.Contains(valueKeys(), fullKey):
+			validateScalar(fullKey, value, addf)
+		default:
+			addf(key, "unknown key %q in create%s", key.Value, where)
+		}
+	}
+}
+
+// validateScalar checks that node is a scalar.
+func validateScalar(key string, node *yaml.Node, addf func(*yaml.Node, string, ...any)) bool {
+	if node.Kind != yaml.ScalarNode {
+		addf(node, "%s must be a string", key)
+		return false
+	}
+	return true
+}
+
+// validateValue checks the value of a server, project, netrc or output key.
+func validateValue(key string, node *yaml.Node, addf func(*yaml.Node, string, ...any)) {
+	if !validateScalar(key, node, addf) || node.Value == "" {
+		return
+	}
+	switch key {
+	case keyServer:
+		u, err := url.Parse(node.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			addf(node, "server must be an http(s) URL, got %q", node.Value)
+		}
+	case keyProject:
+		if !projectKeyPattern.MatchString(node.Value) {
+			addf(node, "project must be a Jira project key (e.g., PROJ), got %q", node.Value)
+		}
+	case keyOutput:
+		if node.Value != jira4claude.OutputMarkdown && node.Value != jira4claude.OutputJSON {
+			addf(node, "output must be markdown or json, got %q", node.Value)
+		}
+	}
+}
+
+// validateDefaultProfile checks that every default_profile names a defined profile.
+// Parsing already succeeded, so read errors cannot occur here.
+func validateDefaultProfile(paths []string) []string {
+	layers, err := readLayers(paths)
+	if err != nil {
+		return nil
+	}
+	names := profileNames(layers)
+	var problems []string
+	for _, l := range layers {
+		if l.file.DefaultProfile != "" && !slices.Contains(names, l.file.DefaultProfile) {
+			problems = append(problems, fmt.Sprintf("%s: default_profile %q is not defined", l.path, l.file.DefaultProfile))
+		}
+	}
+	return problems
+}
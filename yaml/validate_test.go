@@ -0,0 +1,107 @@
+package yaml_test
+
+import (
+	"testing"
+
+	"github.com/fwojciec/jira4claude"
+	"github.com/fwojciec/jira4claude/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts a valid config", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `
+server: https://example.atlassian.net
+project: TEST
+output: json
+default_profile: web
+profiles:
+  web:
+    project: WEB
+`)
+		err := yaml.NewService().Validate([]string{path}, "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("reports unknown keys with file and line", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: https://example.atlassian.net
+project: TEST
+projcet: TYPO
+profiles:
+  web:
+    colour: blue
+`)
+		err := yaml.Validate([]string{path}, "")
+
+		require.Error(t, err)
+		assert.Equal(t, jira4claude.EValidation, jira4claude.ErrorCode(err))
+		assert.Contains(t, err.Error(), path+`:3: unknown key "projcet"`)
+		assert.Contains(t, err.Error(), path+`:6: unknown key "colour" in profile "web"`)
+	})
+
+	t.Run("reports invalid create defaults", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: https://example.atlassian.net
+project: TEST
+create:
+  labels: backend
+  colour: blue
+`)
+		err := yaml.Validate([]string{path}, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), path+":4: create.labels must be a list")
+		assert.Contains(t, err.Error(), path+`:5: unknown key "colour" in create`)
+	})
+
+	t.Run("reports invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: example.atlassian.net
+project: test
+output: xml
+`)
+		err := yaml.Validate([]string{path}, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server must be an http(s) URL")
+		assert.Contains(t, err.Error(), "project must be a Jira project key")
+		assert.Contains(t, err.Error(), "output must be markdown or json")
+	})
+
+	t.Run("reports missing required fields after merging", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `project: TEST
+`)
+		err := yaml.Validate([]string{path}, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required field: server")
+	})
+
+	t.Run("reports undefined default profile", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfigFile(t, `server: https://example.atlassian.net
+project: TEST
+default_profile: gone
+profiles:
+  web:
+    project: WEB
+`)
+		err := yaml.Validate([]string{path}, "web")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `default_profile "gone" is not defined`)
+	})
+}